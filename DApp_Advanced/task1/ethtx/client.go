@@ -0,0 +1,575 @@
+package ethtx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ErrSendUncertain 发送交易的请求可能已经送达节点（超时、连接中断、5xx），无法确定交易是否已广播。
+// 调用方不能用同一nonce重建交易，应等待已签名的交易上链或重新广播同一笔交易
+var ErrSendUncertain = errors.New("transaction may have been broadcast")
+
+// ErrChainIDMismatch 端点返回的链ID与配置不一致，该端点不再使用
+var ErrChainIDMismatch = errors.New("chain ID mismatch")
+
+// rateLimitCode 节点服务商限流时返回的JSON-RPC错误码（Infura、Alchemy 等）
+const rateLimitCode = -32005
+
+// ClientConfig 多端点客户端配置
+type ClientConfig struct {
+	Endpoints   []string      // 节点端点，按优先级排列
+	ChainID     *big.Int      // 不为nil时每个端点第一次使用前核对链ID，不一致的端点不再使用
+	MaxAttempts int           // 每次调用最多请求的次数（所有端点合计），默认每个端点2次且不少于3次
+	BaseBackoff time.Duration // 所有端点都失败后的第一次退避时间，之后每轮加倍
+	MaxBackoff  time.Duration // 退避时间上限，也是失败端点暂停使用的最长时间
+	CallTimeout time.Duration // 单次请求的超时时间，0表示只受调用方 ctx 控制
+}
+
+// DefaultClientConfig 返回默认配置：退避200ms起、最长5秒，单次请求30秒超时
+func DefaultClientConfig(endpoints ...string) ClientConfig {
+	return ClientConfig{
+		Endpoints:   endpoints,
+		BaseBackoff: 200 * time.Millisecond,
+		MaxBackoff:  5 * time.Second,
+		CallTimeout: 30 * time.Second,
+	}
+}
+
+// endpoint 一个节点端点及其状态
+type endpoint struct {
+	name     string // 隐藏了路径（API密钥）的地址
+	client   *ethclient.Client
+	verified bool      // 链ID已核对
+	disabled error     // 不为nil时不再使用
+	pausedTo time.Time // 失败后暂停使用到该时间
+}
+
+// Client 在多个节点端点之间重试和故障转移的以太坊客户端，实现了 ethtx 和 abigen 绑定需要的节点接口：
+//   - 查询类请求（幂等）在限流（HTTP 429、-32005）、5xx、超时和连接错误时换下一个端点重试，
+//     所有端点都失败后按指数退避重试，最多 MaxAttempts 次；失败的端点暂停使用一段时间
+//   - 合约回滚、交易不存在等节点正常返回的错误不重试
+//   - SendTransaction 只把同一笔已签名的交易发给其他端点，交易哈希相同，不会重复付款；
+//     无法确定是否已广播时返回 ErrSendUncertain，不会让调用方用新的nonce重建交易
+type Client struct {
+	cfg       ClientConfig
+	mu        sync.Mutex
+	endpoints []*endpoint
+	current   int // 最近一次成功的端点
+}
+
+// 编译期检查 Client 实现了发送交易、等待上链和合约绑定需要的接口
+var (
+	_ TxClient             = (*Client)(nil)
+	_ ReceiptClient        = (*Client)(nil)
+	_ FeeSource            = (*Client)(nil)
+	_ bind.ContractBackend = (*Client)(nil)
+)
+
+// DialClient 连接配置的所有端点（HTTP端点在第一次请求时才建立连接）
+func DialClient(ctx context.Context, cfg ClientConfig) (*Client, error) {
+	if len(cfg.Endpoints) == 0 {
+		return nil, errors.New("no RPC endpoints configured")
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = max(2*len(cfg.Endpoints), 3)
+	}
+	if cfg.BaseBackoff <= 0 {
+		cfg.BaseBackoff = 200 * time.Millisecond
+	}
+	if cfg.MaxBackoff < cfg.BaseBackoff {
+		cfg.MaxBackoff = cfg.BaseBackoff
+	}
+
+	c := &Client{cfg: cfg}
+	for _, rawURL := range cfg.Endpoints {
+		client, err := ethclient.DialContext(ctx, rawURL)
+		if err != nil {
+			c.Close()
+			return nil, fmt.Errorf("dial %s: %w", EndpointName(rawURL), err)
+		}
+		c.endpoints = append(c.endpoints, &endpoint{name: EndpointName(rawURL), client: client})
+	}
+	return c, nil
+}
+
+// EndpointClient 单个端点的客户端，请求不重试也不切换端点
+type EndpointClient struct {
+	Name string // 隐藏了路径（API密钥）的地址
+	*ethclient.Client
+}
+
+// Endpoints 按配置顺序返回每个端点的单端点客户端，用于需要核对所有节点状态的查询，例如确认一笔交易在任何节点上都不存在。
+// 链ID不一致的端点被跳过；链ID无法核对（例如连接失败）时返回错误，缺少任何一个端点的结果都不能证明交易不存在
+func (c *Client) Endpoints(ctx context.Context) ([]EndpointClient, error) {
+	var (
+		clients []EndpointClient
+		errs    []error
+	)
+	for _, ep := range c.endpoints {
+		c.mu.Lock()
+		disabled := ep.disabled
+		c.mu.Unlock()
+		if disabled != nil {
+			continue
+		}
+		if err := c.verify(ctx, ep); err != nil {
+			if !errors.Is(err, ErrChainIDMismatch) {
+				errs = append(errs, fmt.Errorf("%s: %w", ep.name, err))
+			}
+			continue
+		}
+		clients = append(clients, EndpointClient{Name: ep.name, Client: ep.client})
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("check RPC endpoints: %w", errors.Join(errs...))
+	}
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("no usable RPC endpoints: %w", c.disabledErrors())
+	}
+	return clients, nil
+}
+
+// EndpointName 返回隐藏了路径和查询参数的端点地址（Infura 等服务商的API密钥在路径中），用于日志和错误信息
+func EndpointName(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+	if u.Path != "" && u.Path != "/" || u.RawQuery != "" {
+		return u.Scheme + "://" + u.Host + "/***"
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// Close 关闭所有端点的连接
+func (c *Client) Close() {
+	for _, ep := range c.endpoints {
+		ep.client.Close()
+	}
+}
+
+// candidates 返回本次调用依次尝试的端点：从最近一次成功的端点开始，暂停中的端点按恢复时间排在最后，跳过已停用的端点
+func (c *Client) candidates() []*endpoint {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	var ready, paused []*endpoint
+	for i := range c.endpoints {
+		ep := c.endpoints[(c.current+i)%len(c.endpoints)]
+		switch {
+		case ep.disabled != nil:
+		case ep.pausedTo.After(now):
+			paused = append(paused, ep)
+		default:
+			ready = append(ready, ep)
+		}
+	}
+	sort.SliceStable(paused, func(i, j int) bool { return paused[i].pausedTo.Before(paused[j].pausedTo) })
+	return append(ready, paused...)
+}
+
+// succeeded 记录端点请求成功，之后的调用优先使用该端点
+func (c *Client) succeeded(ep *endpoint) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ep.pausedTo = time.Time{}
+	for i, e := range c.endpoints {
+		if e == ep {
+			c.current = i
+		}
+	}
+}
+
+// failed 暂停使用失败的端点
+func (c *Client) failed(ep *endpoint, pause time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ep.pausedTo = time.Now().Add(pause)
+}
+
+// disable 停用端点
+func (c *Client) disable(ep *endpoint, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ep.disabled = err
+}
+
+// verify 在端点第一次使用前核对链ID
+func (c *Client) verify(ctx context.Context, ep *endpoint) error {
+	c.mu.Lock()
+	verified := ep.verified || c.cfg.ChainID == nil
+	c.mu.Unlock()
+	if verified {
+		return nil
+	}
+	chainID, err := ep.client.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	if chainID.Cmp(c.cfg.ChainID) != 0 {
+		err := fmt.Errorf("%w: endpoint reports %s, want %s", ErrChainIDMismatch, chainID, c.cfg.ChainID)
+		c.disable(ep, err)
+		return err
+	}
+	c.mu.Lock()
+	ep.verified = true
+	c.mu.Unlock()
+	return nil
+}
+
+// attempt 在端点 ep 上执行一次请求，单次请求超时由 CallTimeout 控制
+func attempt[T any](c *Client, ctx context.Context, ep *endpoint, fn func(context.Context, *ethclient.Client) (T, error)) (T, error) {
+	if c.cfg.CallTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.CallTimeout)
+		defer cancel()
+	}
+	if err := c.verify(ctx, ep); err != nil {
+		var zero T
+		return zero, err
+	}
+	return fn(ctx, ep.client)
+}
+
+// call 执行幂等的查询请求：可重试的错误换下一个端点，所有端点都失败后退避重试
+func call[T any](c *Client, ctx context.Context, fn func(context.Context, *ethclient.Client) (T, error)) (T, error) {
+	var (
+		zero     T
+		errs     []error
+		backoff  = c.cfg.BaseBackoff
+		attempts int
+	)
+	for {
+		eps := c.candidates()
+		if len(eps) == 0 {
+			return zero, fmt.Errorf("no usable RPC endpoint: %w", errors.Join(append(errs, c.disabledErrors())...))
+		}
+		for _, ep := range eps {
+			if attempts == c.cfg.MaxAttempts {
+				return zero, fmt.Errorf("RPC request failed after %d attempts: %w", attempts, errors.Join(errs...))
+			}
+			attempts++
+			result, err := attempt(c, ctx, ep, fn)
+			if err == nil {
+				c.succeeded(ep)
+				return result, nil
+			}
+			if ctx.Err() != nil {
+				return zero, err
+			}
+			if errors.Is(err, ErrChainIDMismatch) {
+				errs = append(errs, fmt.Errorf("%s: %w", ep.name, err))
+				continue
+			}
+			if classifyError(err) == errPermanent {
+				return zero, err
+			}
+			errs = append(errs, fmt.Errorf("%s: %w", ep.name, err))
+			c.failed(ep, c.cfg.MaxBackoff)
+		}
+		// 所有端点都失败：退避后开始下一轮
+		select {
+		case <-ctx.Done():
+			return zero, fmt.Errorf("%w: %w", ctx.Err(), errors.Join(errs...))
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, c.cfg.MaxBackoff)
+	}
+}
+
+// disabledErrors 返回所有停用端点的原因
+func (c *Client) disabledErrors() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var errs []error
+	for _, ep := range c.endpoints {
+		if ep.disabled != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ep.name, ep.disabled))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// errorClass 请求失败的类型
+type errorClass int
+
+const (
+	errPermanent errorClass = iota // 节点正常处理并返回的错误，重试没有意义
+	errRejected                    // 请求没有被处理（限流、连接被拒绝），可以安全地换端点重试
+	errTransient                   // 请求可能已被处理（超时、连接中断、5xx），只有幂等请求可以重试
+)
+
+// classifyError 判断请求失败的类型
+func classifyError(err error) errorClass {
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		switch {
+		case httpErr.StatusCode == http.StatusTooManyRequests:
+			return errRejected
+		case httpErr.StatusCode >= 500:
+			return errTransient
+		}
+		return errPermanent
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		if rpcErr.ErrorCode() == rateLimitCode {
+			return errRejected
+		}
+		return errPermanent
+	}
+	var dnsErr *net.DNSError
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.As(err, &dnsErr) {
+		return errRejected
+	}
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.As(err, &netErr) {
+		return errTransient
+	}
+	return errPermanent
+}
+
+// SendTransaction 发送已签名的交易。请求确定没有被处理（限流、连接被拒绝）时发给下一个端点；
+// 请求可能已被处理（超时、5xx）时把同一笔交易发给其他端点，其他端点返回 already known 或已有该交易时视为发送成功，
+// 无法确认时返回包含 ErrSendUncertain 的错误。不做退避重试，每个端点最多发送一次
+func (c *Client) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	var (
+		errs      []error
+		uncertain bool
+	)
+	for _, ep := range c.candidates() {
+		// 链ID核对失败时交易还没有发出
+		if err := c.verify(ctx, ep); err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			errs = append(errs, fmt.Errorf("%s: %w", ep.name, err))
+			continue
+		}
+		_, err := attempt(c, ctx, ep, func(ctx context.Context, client *ethclient.Client) (struct{}, error) {
+			return struct{}{}, client.SendTransaction(ctx, tx)
+		})
+		switch {
+		case err == nil:
+			c.succeeded(ep)
+			return nil
+		case uncertain && isAlreadyKnown(err):
+			return nil
+		case uncertain && isNonceTooLow(err):
+			// nonce已被使用：可能是之前送达的这笔交易已经上链，也可能是其他交易
+			if _, _, lookupErr := ep.client.TransactionByHash(ctx, tx.Hash()); lookupErr == nil {
+				return nil
+			}
+			errs = append(errs, fmt.Errorf("%s: %w", ep.name, err))
+			return c.uncertainError(tx, errs)
+		case ctx.Err() != nil:
+			// 请求发出后被取消，无法确定节点是否已收到
+			return c.uncertainError(tx, append(errs, fmt.Errorf("%s: %w", ep.name, err)))
+		}
+
+		switch classifyError(err) {
+		case errRejected:
+		case errTransient:
+			uncertain = true
+		default:
+			if uncertain {
+				return c.uncertainError(tx, append(errs, fmt.Errorf("%s: %w", ep.name, err)))
+			}
+			return err
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", ep.name, err))
+		c.failed(ep, c.cfg.MaxBackoff)
+	}
+	if uncertain {
+		return c.uncertainError(tx, errs)
+	}
+	return fmt.Errorf("send transaction: all RPC endpoints failed: %w", errors.Join(append(errs, c.disabledErrors())...))
+}
+
+// uncertainError 返回包含 ErrSendUncertain 的错误
+func (c *Client) uncertainError(tx *types.Transaction, errs []error) error {
+	return fmt.Errorf("send transaction %s: %w: %w", tx.Hash().Hex(), ErrSendUncertain, errors.Join(errs...))
+}
+
+// subscribe 在第一个成功建立订阅的端点上订阅，订阅建立后不做故障转移（由调用方在订阅出错时重新订阅）
+func subscribe(c *Client, ctx context.Context, fn func(context.Context, *ethclient.Client) (ethereum.Subscription, error)) (ethereum.Subscription, error) {
+	var errs []error
+	for _, ep := range c.candidates() {
+		if err := c.verify(ctx, ep); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ep.name, err))
+			continue
+		}
+		sub, err := fn(ctx, ep.client)
+		if err == nil {
+			return sub, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", ep.name, err))
+	}
+	return nil, fmt.Errorf("subscribe: %w", errors.Join(errs...))
+}
+
+// ChainID 查询链ID
+func (c *Client) ChainID(ctx context.Context) (*big.Int, error) {
+	return call(c, ctx, func(ctx context.Context, client *ethclient.Client) (*big.Int, error) {
+		return client.ChainID(ctx)
+	})
+}
+
+// BlockNumber 查询最新区块号
+func (c *Client) BlockNumber(ctx context.Context) (uint64, error) {
+	return call(c, ctx, func(ctx context.Context, client *ethclient.Client) (uint64, error) {
+		return client.BlockNumber(ctx)
+	})
+}
+
+// BlockByNumber 按区块号查询区块，number 为nil时查询最新区块
+func (c *Client) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return call(c, ctx, func(ctx context.Context, client *ethclient.Client) (*types.Block, error) {
+		return client.BlockByNumber(ctx, number)
+	})
+}
+
+// BlockByHash 按区块哈希查询区块
+func (c *Client) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	return call(c, ctx, func(ctx context.Context, client *ethclient.Client) (*types.Block, error) {
+		return client.BlockByHash(ctx, hash)
+	})
+}
+
+// HeaderByNumber 按区块号查询区块头，number 为nil时查询最新区块
+func (c *Client) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return call(c, ctx, func(ctx context.Context, client *ethclient.Client) (*types.Header, error) {
+		return client.HeaderByNumber(ctx, number)
+	})
+}
+
+// txLookup TransactionByHash 的返回值
+type txLookup struct {
+	tx        *types.Transaction
+	isPending bool
+}
+
+// TransactionByHash 按哈希查询交易
+func (c *Client) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	result, err := call(c, ctx, func(ctx context.Context, client *ethclient.Client) (txLookup, error) {
+		tx, isPending, err := client.TransactionByHash(ctx, hash)
+		return txLookup{tx, isPending}, err
+	})
+	return result.tx, result.isPending, err
+}
+
+// TransactionReceipt 查询交易回执
+func (c *Client) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return call(c, ctx, func(ctx context.Context, client *ethclient.Client) (*types.Receipt, error) {
+		return client.TransactionReceipt(ctx, txHash)
+	})
+}
+
+// BalanceAt 查询账户在指定区块的余额
+func (c *Client) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return call(c, ctx, func(ctx context.Context, client *ethclient.Client) (*big.Int, error) {
+		return client.BalanceAt(ctx, account, blockNumber)
+	})
+}
+
+// PendingBalanceAt 查询账户包含交易池中交易的余额
+func (c *Client) PendingBalanceAt(ctx context.Context, account common.Address) (*big.Int, error) {
+	return call(c, ctx, func(ctx context.Context, client *ethclient.Client) (*big.Int, error) {
+		return client.PendingBalanceAt(ctx, account)
+	})
+}
+
+// NonceAt 查询账户在指定区块的nonce
+func (c *Client) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return call(c, ctx, func(ctx context.Context, client *ethclient.Client) (uint64, error) {
+		return client.NonceAt(ctx, account, blockNumber)
+	})
+}
+
+// PendingNonceAt 查询账户包含交易池中交易的nonce
+func (c *Client) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return call(c, ctx, func(ctx context.Context, client *ethclient.Client) (uint64, error) {
+		return client.PendingNonceAt(ctx, account)
+	})
+}
+
+// CodeAt 查询合约在指定区块的代码
+func (c *Client) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return call(c, ctx, func(ctx context.Context, client *ethclient.Client) ([]byte, error) {
+		return client.CodeAt(ctx, account, blockNumber)
+	})
+}
+
+// PendingCodeAt 查询合约包含交易池状态的代码
+func (c *Client) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return call(c, ctx, func(ctx context.Context, client *ethclient.Client) ([]byte, error) {
+		return client.PendingCodeAt(ctx, account)
+	})
+}
+
+// CallContract 在指定区块的状态上执行 eth_call
+func (c *Client) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return call(c, ctx, func(ctx context.Context, client *ethclient.Client) ([]byte, error) {
+		return client.CallContract(ctx, msg, blockNumber)
+	})
+}
+
+// EstimateGas 估算交易的gas
+func (c *Client) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	return call(c, ctx, func(ctx context.Context, client *ethclient.Client) (uint64, error) {
+		return client.EstimateGas(ctx, msg)
+	})
+}
+
+// SuggestGasPrice 查询建议的gas价格
+func (c *Client) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return call(c, ctx, func(ctx context.Context, client *ethclient.Client) (*big.Int, error) {
+		return client.SuggestGasPrice(ctx)
+	})
+}
+
+// SuggestGasTipCap 查询建议的小费
+func (c *Client) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return call(c, ctx, func(ctx context.Context, client *ethclient.Client) (*big.Int, error) {
+		return client.SuggestGasTipCap(ctx)
+	})
+}
+
+// FilterLogs 查询日志
+func (c *Client) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return call(c, ctx, func(ctx context.Context, client *ethclient.Client) ([]types.Log, error) {
+		return client.FilterLogs(ctx, query)
+	})
+}
+
+// SubscribeFilterLogs 订阅日志（需要WebSocket端点）
+func (c *Client) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return subscribe(c, ctx, func(ctx context.Context, client *ethclient.Client) (ethereum.Subscription, error) {
+		return client.SubscribeFilterLogs(ctx, query, ch)
+	})
+}
+
+// SubscribeNewHead 订阅新区块头（需要WebSocket端点）
+func (c *Client) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return subscribe(c, ctx, func(ctx context.Context, client *ethclient.Client) (ethereum.Subscription, error) {
+		return client.SubscribeNewHead(ctx, ch)
+	})
+}
@@ -0,0 +1,312 @@
+package ethtx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// rpcReply 模拟节点对一次请求的响应：status 不为0时返回HTTP错误，errCode 不为0时返回JSON-RPC错误
+type rpcReply struct {
+	status  int
+	result  any
+	errCode int
+	errMsg  string
+	delay   time.Duration
+}
+
+// fakeNode 模拟JSON-RPC节点，记录每个方法收到的请求次数和原始参数
+type fakeNode struct {
+	mu     sync.Mutex
+	calls  map[string]int
+	params map[string][]json.RawMessage
+	reply  func(method string, call int) rpcReply
+}
+
+func newFakeNode(t *testing.T, reply func(method string, call int) rpcReply) (*fakeNode, string) {
+	t.Helper()
+	node := &fakeNode{calls: map[string]int{}, params: map[string][]json.RawMessage{}, reply: reply}
+	server := httptest.NewServer(node)
+	t.Cleanup(server.Close)
+	return node, server.URL
+}
+
+func (n *fakeNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	n.mu.Lock()
+	n.calls[req.Method]++
+	n.params[req.Method] = append(n.params[req.Method], req.Params)
+	reply := n.reply(req.Method, n.calls[req.Method])
+	n.mu.Unlock()
+
+	if reply.delay > 0 {
+		select {
+		case <-time.After(reply.delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+	if reply.status != 0 {
+		http.Error(w, http.StatusText(reply.status), reply.status)
+		return
+	}
+	resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+	if reply.errCode != 0 {
+		resp["error"] = map[string]any{"code": reply.errCode, "message": reply.errMsg}
+	} else {
+		resp["result"] = reply.result
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (n *fakeNode) count(method string) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.calls[method]
+}
+
+func testClient(t *testing.T, cfg ClientConfig) *Client {
+	t.Helper()
+	cfg.BaseBackoff = time.Millisecond
+	cfg.MaxBackoff = 50 * time.Millisecond
+	client, err := DialClient(context.Background(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Close)
+	return client
+}
+
+// blockNumber 返回区块号 n 的节点
+func blockNumber(n uint64) func(string, int) rpcReply {
+	return func(string, int) rpcReply { return rpcReply{result: hexutil.Uint64(n)} }
+}
+
+func TestClientFailsOverOnRateLimit(t *testing.T) {
+	limited, url1 := newFakeNode(t, func(string, int) rpcReply { return rpcReply{status: http.StatusTooManyRequests} })
+	healthy, url2 := newFakeNode(t, blockNumber(16))
+	client := testClient(t, ClientConfig{Endpoints: []string{url1, url2}})
+
+	for i := 0; i < 3; i++ {
+		n, err := client.BlockNumber(context.Background())
+		if err != nil || n != 16 {
+			t.Fatalf("BlockNumber = %d, %v", n, err)
+		}
+	}
+	// 限流的端点暂停使用，之后的请求直接发给正常的端点
+	if got := limited.count("eth_blockNumber"); got != 1 {
+		t.Errorf("rate-limited endpoint got %d requests, want 1", got)
+	}
+	if got := healthy.count("eth_blockNumber"); got != 3 {
+		t.Errorf("healthy endpoint got %d requests, want 3", got)
+	}
+}
+
+func TestClientRetriesTransientErrorsWithBackoff(t *testing.T) {
+	node, url := newFakeNode(t, func(_ string, call int) rpcReply {
+		if call <= 2 {
+			return rpcReply{status: http.StatusBadGateway}
+		}
+		return rpcReply{result: hexutil.Uint64(7)}
+	})
+	client := testClient(t, ClientConfig{Endpoints: []string{url}})
+
+	n, err := client.BlockNumber(context.Background())
+	if err != nil || n != 7 {
+		t.Fatalf("BlockNumber = %d, %v", n, err)
+	}
+	if got := node.count("eth_blockNumber"); got != 3 {
+		t.Errorf("got %d requests, want 3", got)
+	}
+
+	// 超过最大次数后返回所有端点的错误
+	failing, url2 := newFakeNode(t, func(string, int) rpcReply { return rpcReply{status: http.StatusServiceUnavailable} })
+	client = testClient(t, ClientConfig{Endpoints: []string{url2}, MaxAttempts: 4})
+	if _, err := client.BlockNumber(context.Background()); err == nil || !strings.Contains(err.Error(), "after 4 attempts") {
+		t.Errorf("err = %v", err)
+	}
+	if got := failing.count("eth_blockNumber"); got != 4 {
+		t.Errorf("got %d requests, want 4", got)
+	}
+}
+
+func TestClientDoesNotRetryNodeErrors(t *testing.T) {
+	first, url1 := newFakeNode(t, func(string, int) rpcReply {
+		return rpcReply{errCode: 3, errMsg: "execution reverted: Count cannot be negative"}
+	})
+	second, url2 := newFakeNode(t, blockNumber(1))
+	client := testClient(t, ClientConfig{Endpoints: []string{url1, url2}})
+
+	_, err := client.EstimateGas(context.Background(), ethereum.CallMsg{})
+	if err == nil || !strings.Contains(err.Error(), "Count cannot be negative") {
+		t.Fatalf("err = %v", err)
+	}
+	if first.count("eth_estimateGas") != 1 || second.count("eth_estimateGas") != 0 {
+		t.Errorf("revert was retried: %d, %d", first.count("eth_estimateGas"), second.count("eth_estimateGas"))
+	}
+}
+
+func TestClientSkipsEndpointOnWrongChain(t *testing.T) {
+	reply := func(chainID uint64) func(string, int) rpcReply {
+		// eth_chainId 和 eth_blockNumber 都返回链ID，便于区分响应来自哪个端点
+		return func(string, int) rpcReply { return rpcReply{result: hexutil.Uint64(chainID)} }
+	}
+	mainnet, url1 := newFakeNode(t, reply(1))
+	_, url2 := newFakeNode(t, reply(11155111))
+	cfg := ClientConfig{Endpoints: []string{url1, url2}, ChainID: big.NewInt(11155111)}
+	client := testClient(t, cfg)
+
+	for i := 0; i < 2; i++ {
+		n, err := client.BlockNumber(context.Background())
+		if err != nil || n != 11155111 {
+			t.Fatalf("BlockNumber = %d, %v", n, err)
+		}
+	}
+	if got := mainnet.count("eth_blockNumber"); got != 0 {
+		t.Errorf("wrong-chain endpoint served %d requests", got)
+	}
+	if got := mainnet.count("eth_chainId"); got != 1 {
+		t.Errorf("wrong-chain endpoint checked %d times, want 1", got)
+	}
+
+	// 没有可用端点时报告链ID不一致
+	client = testClient(t, ClientConfig{Endpoints: []string{url1}, ChainID: cfg.ChainID})
+	if _, err := client.BlockNumber(context.Background()); !errors.Is(err, ErrChainIDMismatch) {
+		t.Errorf("err = %v, want ErrChainIDMismatch", err)
+	}
+}
+
+func TestClientEndpoints(t *testing.T) {
+	chainID := func(id uint64) func(string, int) rpcReply {
+		return func(string, int) rpcReply { return rpcReply{result: hexutil.Uint64(id)} }
+	}
+	_, url1 := newFakeNode(t, chainID(11155111))
+	_, url2 := newFakeNode(t, chainID(1))
+	_, url3 := newFakeNode(t, chainID(11155111))
+	cfg := ClientConfig{Endpoints: []string{url1, url2, url3}, ChainID: big.NewInt(11155111)}
+	client := testClient(t, cfg)
+
+	// 链ID不一致的端点被跳过，其余端点按配置顺序返回
+	endpoints, err := client.Endpoints(context.Background())
+	if err != nil || len(endpoints) != 2 || endpoints[0].Name != EndpointName(url1) || endpoints[1].Name != EndpointName(url3) {
+		t.Fatalf("Endpoints = %+v, %v", endpoints, err)
+	}
+	if n, err := endpoints[1].BlockNumber(context.Background()); err != nil || n != 11155111 {
+		t.Errorf("BlockNumber on %s = %d, %v", endpoints[1].Name, n, err)
+	}
+
+	// 无法核对链ID的端点不能省略
+	_, url4 := newFakeNode(t, func(string, int) rpcReply { return rpcReply{status: http.StatusBadGateway} })
+	client = testClient(t, ClientConfig{Endpoints: []string{url1, url4}, ChainID: cfg.ChainID})
+	if _, err := client.Endpoints(context.Background()); err == nil || !strings.Contains(err.Error(), EndpointName(url4)) {
+		t.Errorf("unreachable endpoint: err = %v", err)
+	}
+}
+
+func TestClientSendTransaction(t *testing.T) {
+	tx := signedTestTx(t)
+	sent := rpcReply{result: tx.Hash()}
+
+	t.Run("rate limited endpoint is skipped", func(t *testing.T) {
+		limited, url1 := newFakeNode(t, func(string, int) rpcReply { return rpcReply{status: http.StatusTooManyRequests} })
+		healthy, url2 := newFakeNode(t, func(string, int) rpcReply { return sent })
+		client := testClient(t, ClientConfig{Endpoints: []string{url1, url2}})
+		if err := client.SendTransaction(context.Background(), tx); err != nil {
+			t.Fatal(err)
+		}
+		if limited.count("eth_sendRawTransaction") != 1 || healthy.count("eth_sendRawTransaction") != 1 {
+			t.Errorf("requests: %d, %d", limited.count("eth_sendRawTransaction"), healthy.count("eth_sendRawTransaction"))
+		}
+	})
+
+	t.Run("node error is not retried", func(t *testing.T) {
+		first, url1 := newFakeNode(t, func(string, int) rpcReply {
+			return rpcReply{errCode: -32000, errMsg: "insufficient funds for gas * price + value"}
+		})
+		second, url2 := newFakeNode(t, func(string, int) rpcReply { return sent })
+		client := testClient(t, ClientConfig{Endpoints: []string{url1, url2}})
+		err := client.SendTransaction(context.Background(), tx)
+		if err == nil || errors.Is(err, ErrSendUncertain) || !strings.Contains(err.Error(), "insufficient funds") {
+			t.Fatalf("err = %v", err)
+		}
+		if first.count("eth_sendRawTransaction") != 1 || second.count("eth_sendRawTransaction") != 0 {
+			t.Errorf("requests: %d, %d", first.count("eth_sendRawTransaction"), second.count("eth_sendRawTransaction"))
+		}
+	})
+
+	t.Run("timeout rebroadcasts the same transaction", func(t *testing.T) {
+		slow, url1 := newFakeNode(t, func(string, int) rpcReply { return rpcReply{delay: time.Second} })
+		second, url2 := newFakeNode(t, func(string, int) rpcReply {
+			return rpcReply{errCode: -32000, errMsg: "already known"}
+		})
+		client := testClient(t, ClientConfig{Endpoints: []string{url1, url2}, CallTimeout: 50 * time.Millisecond})
+		if err := client.SendTransaction(context.Background(), tx); err != nil {
+			t.Fatal(err)
+		}
+		if slow.count("eth_sendRawTransaction") != 1 {
+			t.Errorf("timed out endpoint got %d requests, want 1", slow.count("eth_sendRawTransaction"))
+		}
+		// 发给第二个端点的是同一笔已签名的交易
+		raw, _ := tx.MarshalBinary()
+		if params := second.params["eth_sendRawTransaction"]; len(params) != 1 || !strings.Contains(string(params[0]), hexutil.Encode(raw)) {
+			t.Errorf("second endpoint got %s", params)
+		}
+	})
+
+	t.Run("uncertain when delivery cannot be confirmed", func(t *testing.T) {
+		_, url1 := newFakeNode(t, func(string, int) rpcReply { return rpcReply{status: http.StatusBadGateway} })
+		second, url2 := newFakeNode(t, func(method string, _ int) rpcReply {
+			if method == "eth_getTransactionByHash" {
+				return rpcReply{result: nil}
+			}
+			return rpcReply{errCode: -32000, errMsg: "nonce too low"}
+		})
+		client := testClient(t, ClientConfig{Endpoints: []string{url1, url2}})
+		err := client.SendTransaction(context.Background(), tx)
+		if !errors.Is(err, ErrSendUncertain) {
+			t.Fatalf("err = %v, want ErrSendUncertain", err)
+		}
+		if second.count("eth_getTransactionByHash") != 1 {
+			t.Errorf("transaction lookup not performed")
+		}
+
+		// 所有端点都返回5xx时同样无法确定
+		_, url3 := newFakeNode(t, func(string, int) rpcReply { return rpcReply{status: http.StatusInternalServerError} })
+		client = testClient(t, ClientConfig{Endpoints: []string{url1, url3}})
+		if err := client.SendTransaction(context.Background(), tx); !errors.Is(err, ErrSendUncertain) {
+			t.Errorf("err = %v, want ErrSendUncertain", err)
+		}
+	})
+}
+
+func TestEndpointNameHidesAPIKey(t *testing.T) {
+	for url, want := range map[string]string{
+		"https://sepolia.infura.io/v3/secret":    "https://sepolia.infura.io/***",
+		"https://eth.example.org/rpc?key=secret": "https://eth.example.org/***",
+		"http://127.0.0.1:8545":                  "http://127.0.0.1:8545",
+		"wss://mainnet.infura.io/ws/v3/secret":   "wss://mainnet.infura.io/***",
+	} {
+		if got := EndpointName(url); got != want {
+			t.Errorf("EndpointName(%s) = %s, want %s", url, got, want)
+		}
+	}
+}
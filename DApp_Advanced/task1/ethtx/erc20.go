@@ -0,0 +1,127 @@
+package ethtx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// erc20ABIJSON ERC-20 中用到的函数；MyERC20Token 把 symbol 写成了 symbal，一并列出
+const erc20ABIJSON = `[
+	{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"symbal","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"allowance","stateMutability":"view","inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"approve","stateMutability":"nonpayable","inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+// ERC20ABI 解析后的 ERC-20 ABI
+var ERC20ABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(erc20ABIJSON))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// ErrNoContract 地址上没有合约代码（调用返回空数据）
+var ErrNoContract = errors.New("no contract code at address")
+
+// Token ERC-20 代币，查询通过 eth_call，转账和授权只负责ABI编码调用数据，
+// 由调用方按 EIP-1559 手续费、gas估算和 Sender 的nonce管理发送
+type Token struct {
+	Address  common.Address
+	Symbol   string // 合约没有 symbol()/symbal() 时为空
+	Decimals uint8
+
+	caller ethereum.ContractCaller
+}
+
+// NewToken 读取代币的精度和符号，精度读取失败时返回错误（通常是地址不是ERC-20合约）
+func NewToken(ctx context.Context, caller ethereum.ContractCaller, address common.Address) (*Token, error) {
+	token := &Token{Address: address, caller: caller}
+
+	out, err := token.call(ctx, "decimals")
+	if err != nil {
+		return nil, fmt.Errorf("token %s: %w", address.Hex(), err)
+	}
+	token.Decimals = out[0].(uint8)
+
+	// symbol 是ERC-20的可选函数
+	for _, method := range []string{"symbol", "symbal"} {
+		if out, err := token.call(ctx, method); err == nil {
+			token.Symbol = out[0].(string)
+			break
+		}
+	}
+	return token, nil
+}
+
+// call 调用只读函数并解码返回值
+func (t *Token) call(ctx context.Context, method string, args ...interface{}) ([]interface{}, error) {
+	data, err := ERC20ABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("pack %s: %w", method, err)
+	}
+	out, err := t.caller.CallContract(ctx, ethereum.CallMsg{To: &t.Address, Data: data}, nil)
+	if err != nil {
+		return nil, wrapRevert("call "+method, err)
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("call %s: %w", method, ErrNoContract)
+	}
+	values, err := ERC20ABI.Unpack(method, out)
+	if err != nil {
+		return nil, fmt.Errorf("unpack %s: %w", method, err)
+	}
+	return values, nil
+}
+
+// BalanceOf 查询账户余额（最小单位）
+func (t *Token) BalanceOf(ctx context.Context, account common.Address) (*big.Int, error) {
+	out, err := t.call(ctx, "balanceOf", account)
+	if err != nil {
+		return nil, err
+	}
+	return out[0].(*big.Int), nil
+}
+
+// Allowance 查询 owner 授权给 spender 的额度（最小单位）
+func (t *Token) Allowance(ctx context.Context, owner, spender common.Address) (*big.Int, error) {
+	out, err := t.call(ctx, "allowance", owner, spender)
+	if err != nil {
+		return nil, err
+	}
+	return out[0].(*big.Int), nil
+}
+
+// PackTransfer 编码 transfer(to, amount) 的调用数据
+func (t *Token) PackTransfer(to common.Address, amount *big.Int) ([]byte, error) {
+	return ERC20ABI.Pack("transfer", to, amount)
+}
+
+// PackApprove 编码 approve(spender, amount) 的调用数据
+func (t *Token) PackApprove(spender common.Address, amount *big.Int) ([]byte, error) {
+	return ERC20ABI.Pack("approve", spender, amount)
+}
+
+// ParseAmount 按代币精度解析金额，例如精度为18时 "1.5" 为 1.5e18
+func (t *Token) ParseAmount(s string) (*big.Int, error) {
+	return ParseUnits(s, t.Decimals)
+}
+
+// FormatAmount 按代币精度格式化金额，带上代币符号
+func (t *Token) FormatAmount(amount *big.Int) string {
+	if t.Symbol == "" {
+		return FormatUnits(amount, t.Decimals)
+	}
+	return FormatUnits(amount, t.Decimals) + " " + t.Symbol
+}
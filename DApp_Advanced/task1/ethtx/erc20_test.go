@@ -0,0 +1,123 @@
+package ethtx
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// stubTokenCaller 按函数名返回ABI编码的结果，没有配置的函数回滚
+type stubTokenCaller struct {
+	t       *testing.T
+	results map[string][]interface{}
+	calls   []ethereum.CallMsg
+}
+
+func (c *stubTokenCaller) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	c.calls = append(c.calls, msg)
+	method, err := ERC20ABI.MethodById(msg.Data[:4])
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	values, ok := c.results[method.Name]
+	if !ok {
+		return nil, rpcRevertError{data: "0x"}
+	}
+	return method.Outputs.Pack(values...)
+}
+
+func TestNewToken(t *testing.T) {
+	address := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	// MyERC20Token 只有 symbal()
+	caller := &stubTokenCaller{t: t, results: map[string][]interface{}{
+		"decimals": {uint8(6)},
+		"symbal":   {"MTK"},
+	}}
+	token, err := NewToken(context.Background(), caller, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.Decimals != 6 || token.Symbol != "MTK" {
+		t.Errorf("token = %+v, want decimals 6, symbol MTK", token)
+	}
+	if *caller.calls[0].To != address {
+		t.Errorf("called %s, want %s", caller.calls[0].To.Hex(), address.Hex())
+	}
+
+	// 没有符号函数时符号为空
+	caller.results = map[string][]interface{}{"decimals": {uint8(18)}}
+	token, err = NewToken(context.Background(), caller, address)
+	if err != nil || token.Symbol != "" || token.FormatAmount(big.NewInt(5e17)) != "0.5" {
+		t.Errorf("token without symbol = %+v, %v", token, err)
+	}
+}
+
+func TestNewTokenNoContract(t *testing.T) {
+	_, err := NewToken(context.Background(), callerFunc(func(ethereum.CallMsg) ([]byte, error) {
+		return nil, nil
+	}), common.Address{1})
+	if !errors.Is(err, ErrNoContract) {
+		t.Errorf("err = %v, want ErrNoContract", err)
+	}
+}
+
+type callerFunc func(msg ethereum.CallMsg) ([]byte, error)
+
+func (f callerFunc) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	return f(msg)
+}
+
+func TestTokenQueriesAndCalldata(t *testing.T) {
+	owner := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	spender := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	balance, _ := new(big.Int).SetString("1234500000000000000000", 10)
+	caller := &stubTokenCaller{t: t, results: map[string][]interface{}{
+		"decimals":  {uint8(18)},
+		"symbol":    {"SHIB"},
+		"balanceOf": {balance},
+		"allowance": {big.NewInt(1e18)},
+	}}
+	token, err := NewToken(context.Background(), caller, common.Address{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := token.BalanceOf(context.Background(), owner)
+	if err != nil || token.FormatAmount(got) != "1234.5 SHIB" {
+		t.Errorf("BalanceOf = %v, %v", got, err)
+	}
+	got, err = token.Allowance(context.Background(), owner, spender)
+	if err != nil || got.Cmp(big.NewInt(1e18)) != 0 {
+		t.Errorf("Allowance = %v, %v", got, err)
+	}
+
+	amount, err := token.ParseAmount("2.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for method, pack := range map[string]func(common.Address, *big.Int) ([]byte, error){
+		"transfer": token.PackTransfer,
+		"approve":  token.PackApprove,
+	} {
+		data, err := pack(spender, amount)
+		if err != nil {
+			t.Fatal(err)
+		}
+		args, err := ERC20ABI.Methods[method].Inputs.Unpack(data[4:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if args[0].(common.Address) != spender || args[1].(*big.Int).String() != "2500000000000000000" {
+			t.Errorf("%s args = %v", method, args)
+		}
+	}
+
+	if _, err := token.ParseAmount("0.0000000000000000001"); err == nil {
+		t.Error("ParseAmount with 19 decimals: want error")
+	}
+}
@@ -0,0 +1,46 @@
+package ethtx
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/external"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// externalSigner 通过外部签名服务（例如 Clef）的 JSON-RPC 接口（account_signTransaction）签名，私钥不离开签名服务
+type externalSigner struct {
+	signer  *external.ExternalSigner
+	account accounts.Account
+}
+
+// NewExternalSigner 连接本地的外部签名服务（http:// 或 IPC 路径），address 必须是签名服务管理的账户
+func NewExternalSigner(endpoint string, address common.Address) (Signer, error) {
+	signer, err := external.NewExternalSigner(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("connect external signer %s: %w", endpoint, err)
+	}
+
+	account := accounts.Account{Address: address}
+	if !signer.Contains(account) {
+		signer.Close()
+		return nil, fmt.Errorf("external signer %s does not manage account %s", endpoint, address.Hex())
+	}
+	return &externalSigner{signer: signer, account: account}, nil
+}
+
+func (s *externalSigner) Address() common.Address {
+	return s.account.Address
+}
+
+// SignTx 交给外部签名服务签名，签名服务可能需要人工确认，因此不设置超时
+func (s *externalSigner) SignTx(_ context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signed, err := s.signer.SignTx(s.account, tx, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("external signer: %w", err)
+	}
+	return signed, nil
+}
@@ -0,0 +1,154 @@
+package ethtx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// 手续费策略
+const (
+	FeeStrategySlow   = "slow"   // 建议小费的80%，费用上限为基础费用的1.25倍加小费，便宜但拥堵时可能等待较久
+	FeeStrategyNormal = "normal" // 建议小费，费用上限为基础费用的2倍加小费（与 go-ethereum 默认一致）
+	FeeStrategyFast   = "fast"   // 建议小费的1.5倍，费用上限为基础费用的3倍加小费
+	FeeStrategyFixed  = "fixed"  // 使用配置的小费和费用上限，不查询节点
+)
+
+// feeMultipliers 各策略的小费和基础费用倍数（百分比）
+var feeMultipliers = map[string]struct{ tipPercent, baseFeePercent int64 }{
+	FeeStrategySlow:   {80, 125},
+	FeeStrategyNormal: {100, 200},
+	FeeStrategyFast:   {150, 300},
+}
+
+// ErrNoBaseFee 节点最新区块没有基础费用（链未启用 EIP-1559）
+var ErrNoBaseFee = errors.New("latest block has no base fee, chain does not support EIP-1559")
+
+// FeeConfig EIP-1559 手续费配置，金额以 gwei 为单位，可以带小数
+type FeeConfig struct {
+	Strategy   string `yaml:"strategy"`     // 手续费策略：slow、normal、fast、fixed
+	TipCapGwei string `yaml:"tip_cap_gwei"` // fixed：小费（maxPriorityFeePerGas）
+	FeeCapGwei string `yaml:"fee_cap_gwei"` // fixed：费用上限（maxFeePerGas）
+}
+
+// DefaultFeeConfig 返回默认的手续费配置：normal 策略
+func DefaultFeeConfig() FeeConfig {
+	return FeeConfig{Strategy: FeeStrategyNormal}
+}
+
+// Validate 校验手续费配置
+func (c FeeConfig) Validate() error {
+	if c.Strategy != FeeStrategyFixed {
+		if _, ok := feeMultipliers[c.Strategy]; !ok {
+			return fmt.Errorf("strategy: unknown fee strategy %q (want %s, %s, %s or %s)",
+				c.Strategy, FeeStrategySlow, FeeStrategyNormal, FeeStrategyFast, FeeStrategyFixed)
+		}
+		return nil
+	}
+	_, err := c.fixedFees()
+	return err
+}
+
+// fixedFees 解析 fixed 策略配置的小费和费用上限
+func (c FeeConfig) fixedFees() (*Fees, error) {
+	var errs []error
+	tipCap, err := ParseGwei(c.TipCapGwei)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("tip_cap_gwei: %w", err))
+	}
+	feeCap, err := ParseGwei(c.FeeCapGwei)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("fee_cap_gwei: %w", err))
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	if feeCap.Cmp(tipCap) < 0 {
+		return nil, fmt.Errorf("fee_cap_gwei: %s is less than tip_cap_gwei %s", c.FeeCapGwei, c.TipCapGwei)
+	}
+	return &Fees{TipCap: tipCap, FeeCap: feeCap}, nil
+}
+
+// FeeSource 查询手续费所需的节点接口，*ethclient.Client 实现了该接口
+type FeeSource interface {
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Fees EIP-1559 交易的小费和费用上限（wei）
+type Fees struct {
+	TipCap *big.Int // maxPriorityFeePerGas
+	FeeCap *big.Int // maxFeePerGas
+}
+
+// SuggestFees 按配置的策略计算手续费：小费基于节点建议的小费，费用上限基于最新区块的基础费用
+func SuggestFees(ctx context.Context, client FeeSource, c FeeConfig) (*Fees, error) {
+	if c.Strategy == FeeStrategyFixed {
+		return c.fixedFees()
+	}
+	multiplier, ok := feeMultipliers[c.Strategy]
+	if !ok {
+		return nil, fmt.Errorf("unknown fee strategy %q", c.Strategy)
+	}
+
+	tip, err := client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("suggest gas tip cap: %w", err)
+	}
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get latest header: %w", err)
+	}
+	if head.BaseFee == nil {
+		return nil, ErrNoBaseFee
+	}
+
+	tipCap := percent(tip, multiplier.tipPercent)
+	feeCap := percent(head.BaseFee, multiplier.baseFeePercent)
+	feeCap.Add(feeCap, tipCap)
+	return &Fees{TipCap: tipCap, FeeCap: feeCap}, nil
+}
+
+// Apply 将手续费设置到 abigen 交易选项上，绑定方法会据此创建 DynamicFeeTx
+func (f *Fees) Apply(opts *bind.TransactOpts) {
+	opts.GasPrice = nil
+	opts.GasTipCap = new(big.Int).Set(f.TipCap)
+	opts.GasFeeCap = new(big.Int).Set(f.FeeCap)
+}
+
+// String 以 gwei 显示手续费
+func (f *Fees) String() string {
+	return fmt.Sprintf("tip %s gwei, max fee %s gwei", FormatGwei(f.TipCap), FormatGwei(f.FeeCap))
+}
+
+// percent 返回 x * p / 100
+func percent(x *big.Int, p int64) *big.Int {
+	result := new(big.Int).Mul(x, big.NewInt(p))
+	return result.Quo(result, big.NewInt(100))
+}
+
+// ParseGwei 将 gwei 数额（可以带最多9位小数）转换为 wei
+func ParseGwei(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, errors.New("required")
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok || r.Sign() < 0 {
+		return nil, fmt.Errorf("%q is not a non-negative gwei amount", s)
+	}
+	r.Mul(r, new(big.Rat).SetInt64(params.GWei))
+	if !r.IsInt() {
+		return nil, fmt.Errorf("%q has more than 9 decimal places", s)
+	}
+	return new(big.Int).Set(r.Num()), nil
+}
+
+// FormatGwei 将 wei 格式化为 gwei，去掉小数末尾的0
+func FormatGwei(wei *big.Int) string {
+	return FormatUnits(wei, 9)
+}
@@ -0,0 +1,97 @@
+package ethtx
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// stubFeeSource 返回固定的建议小费和基础费用
+type stubFeeSource struct {
+	tip     *big.Int
+	baseFee *big.Int
+}
+
+func (s stubFeeSource) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return s.tip, nil
+}
+
+func (s stubFeeSource) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(100), BaseFee: s.baseFee}, nil
+}
+
+func TestSuggestFeesStrategies(t *testing.T) {
+	source := stubFeeSource{tip: big.NewInt(2e9), baseFee: big.NewInt(10e9)}
+	for _, tc := range []struct {
+		strategy       string
+		tipCap, feeCap int64
+	}{
+		{FeeStrategySlow, 1.6e9, 12.5e9 + 1.6e9},
+		{FeeStrategyNormal, 2e9, 20e9 + 2e9},
+		{FeeStrategyFast, 3e9, 30e9 + 3e9},
+	} {
+		fees, err := SuggestFees(context.Background(), source, FeeConfig{Strategy: tc.strategy})
+		if err != nil {
+			t.Fatalf("%s: %v", tc.strategy, err)
+		}
+		if fees.TipCap.Int64() != tc.tipCap || fees.FeeCap.Int64() != tc.feeCap {
+			t.Errorf("%s: fees = %s, want tip %d, max fee %d", tc.strategy, fees, tc.tipCap, tc.feeCap)
+		}
+	}
+}
+
+func TestSuggestFeesFixed(t *testing.T) {
+	// fixed 策略不查询节点
+	fees, err := SuggestFees(context.Background(), nil, FeeConfig{Strategy: FeeStrategyFixed, TipCapGwei: "1.5", FeeCapGwei: "30"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fees.TipCap.Int64() != 1.5e9 || fees.FeeCap.Int64() != 30e9 {
+		t.Errorf("fees = %s, want tip 1.5 gwei, max fee 30 gwei", fees)
+	}
+	if got := fees.String(); got != "tip 1.5 gwei, max fee 30 gwei" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestSuggestFeesWithoutBaseFee(t *testing.T) {
+	_, err := SuggestFees(context.Background(), stubFeeSource{tip: big.NewInt(1)}, DefaultFeeConfig())
+	if !errors.Is(err, ErrNoBaseFee) {
+		t.Errorf("err = %v, want ErrNoBaseFee", err)
+	}
+}
+
+func TestFeeConfigValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		config  FeeConfig
+		wantErr bool
+	}{
+		{"normal", DefaultFeeConfig(), false},
+		{"fixed", FeeConfig{Strategy: FeeStrategyFixed, TipCapGwei: "0.001", FeeCapGwei: "2"}, false},
+		{"unknown strategy", FeeConfig{Strategy: "turbo"}, true},
+		{"fixed without values", FeeConfig{Strategy: FeeStrategyFixed}, true},
+		{"fee cap below tip", FeeConfig{Strategy: FeeStrategyFixed, TipCapGwei: "3", FeeCapGwei: "2"}, true},
+		{"too many decimals", FeeConfig{Strategy: FeeStrategyFixed, TipCapGwei: "0.0000000001", FeeCapGwei: "2"}, true},
+	} {
+		if err := tc.config.Validate(); (err != nil) != tc.wantErr {
+			t.Errorf("%s: err = %v, wantErr %v", tc.name, err, tc.wantErr)
+		}
+	}
+}
+
+func TestFeesApplyBuildsDynamicFeeTx(t *testing.T) {
+	opts := &bind.TransactOpts{GasPrice: big.NewInt(1)}
+	fees := &Fees{TipCap: big.NewInt(1e9), FeeCap: big.NewInt(5e9)}
+	fees.Apply(opts)
+	if opts.GasPrice != nil {
+		t.Error("GasPrice is still set, bind would create a legacy transaction")
+	}
+	if opts.GasTipCap.Cmp(fees.TipCap) != 0 || opts.GasFeeCap.Cmp(fees.FeeCap) != 0 {
+		t.Errorf("opts tip/fee cap = %s/%s, want %s/%s", opts.GasTipCap, opts.GasFeeCap, fees.TipCap, fees.FeeCap)
+	}
+}
@@ -0,0 +1,108 @@
+package ethtx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ErrGasCapExceeded 估算的gas超过配置的上限
+var ErrGasCapExceeded = errors.New("estimated gas exceeds cap")
+
+// GasConfig gas限制的估算配置
+type GasConfig struct {
+	Multiplier    float64 `yaml:"multiplier"`     // 估算值的安全系数，不小于1
+	Max           uint64  `yaml:"max"`            // gas限制上限，估算值超过上限时拒绝发送
+	FallbackLimit uint64  `yaml:"fallback_limit"` // 节点无法估算时使用的gas限制，0表示不回退；合约回滚时不会回退
+}
+
+// DefaultGasConfig 返回默认的gas估算配置：估算值的1.2倍，上限1000万，不回退
+func DefaultGasConfig() GasConfig {
+	return GasConfig{Multiplier: 1.2, Max: 10_000_000}
+}
+
+// Validate 校验gas估算配置
+func (c GasConfig) Validate() error {
+	var errs []error
+	if c.Multiplier < 1 {
+		errs = append(errs, fmt.Errorf("multiplier: %v is less than 1", c.Multiplier))
+	}
+	if c.Max == 0 {
+		errs = append(errs, errors.New("max: required"))
+	}
+	if c.FallbackLimit > c.Max {
+		errs = append(errs, fmt.Errorf("fallback_limit: %d exceeds max %d", c.FallbackLimit, c.Max))
+	}
+	return errors.Join(errs...)
+}
+
+// EstimateGasLimit 估算交易的gas限制：估算值乘以安全系数，不超过上限
+// 合约回滚时返回 *RevertError（包含 require 的原因）；节点无法估算时使用配置的回退值
+func EstimateGasLimit(ctx context.Context, client ethereum.GasEstimator, c GasConfig, msg ethereum.CallMsg) (uint64, error) {
+	estimated, err := client.EstimateGas(ctx, msg)
+	if err != nil {
+		if _, reverted := AsRevert(err); reverted || !estimationUnavailable(ctx, err) || c.FallbackLimit == 0 {
+			return 0, wrapRevert("estimate gas", err)
+		}
+		log.Printf("估算gas失败，使用回退值 %d: %v", c.FallbackLimit, err)
+		return c.FallbackLimit, nil
+	}
+
+	if estimated > c.Max {
+		return 0, fmt.Errorf("%w: estimated %d, cap %d", ErrGasCapExceeded, estimated, c.Max)
+	}
+	limit := uint64(float64(estimated) * c.Multiplier)
+	if limit > c.Max {
+		limit = c.Max
+	}
+	return limit, nil
+}
+
+// EstimateTransactGas 按 abigen 交易选项（发送方、金额、手续费）估算调用合约 to 的gas限制，data 为ABI编码的调用数据
+func EstimateTransactGas(ctx context.Context, client ethereum.GasEstimator, c GasConfig, opts *bind.TransactOpts, to common.Address, data []byte) (uint64, error) {
+	return EstimateGasLimit(ctx, client, c, ethereum.CallMsg{
+		From:      opts.From,
+		To:        &to,
+		GasPrice:  opts.GasPrice,
+		GasTipCap: opts.GasTipCap,
+		GasFeeCap: opts.GasFeeCap,
+		Value:     opts.Value,
+		Data:      data,
+	})
+}
+
+// methodNotFoundCode JSON-RPC 规定的方法不存在错误码
+const methodNotFoundCode = -32601
+
+// unsupportedMessages 节点不支持 eth_estimateGas 时常见的错误信息
+var unsupportedMessages = []string{"method not found", "does not exist", "not supported", "unsupported", "not available"}
+
+// estimationUnavailable 判断估算失败是否因为节点暂时无法估算，只有这些情况才使用回退值：
+// 网络错误（连接失败、超时、连接中断）、HTTP 5xx、节点不支持 eth_estimateGas。
+// 其他错误（余额不足、nonce 错误、参数错误、ctx 取消等）说明交易本身有问题，直接返回
+func estimationUnavailable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if classifyError(err) != errPermanent {
+		return true
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == methodNotFoundCode {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	for _, unsupported := range unsupportedMessages {
+		if strings.Contains(msg, unsupported) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package ethtx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// stubEstimator 返回固定的估算值或错误
+type stubEstimator struct {
+	gas uint64
+	err error
+}
+
+func (s stubEstimator) EstimateGas(context.Context, ethereum.CallMsg) (uint64, error) {
+	return s.gas, s.err
+}
+
+// rpcCodeError 模拟节点返回的带错误码的 JSON-RPC 错误
+type rpcCodeError struct {
+	code int
+	msg  string
+}
+
+func (e rpcCodeError) Error() string  { return e.msg }
+func (e rpcCodeError) ErrorCode() int { return e.code }
+
+// rpcRevertError 模拟节点返回的带回滚数据的 JSON-RPC 错误
+type rpcRevertError struct{ data string }
+
+func (e rpcRevertError) Error() string          { return "execution reverted" }
+func (e rpcRevertError) ErrorData() interface{} { return e.data }
+
+// encodeRevert 按 Error(string) 编码回滚原因
+func encodeRevert(t *testing.T, reason string) string {
+	stringType, _ := abi.NewType("string", "", nil)
+	packed, err := abi.Arguments{{Type: stringType}}.Pack(reason)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hexutil.Encode(append(crypto.Keccak256([]byte("Error(string)"))[:4], packed...))
+}
+
+func TestEstimateGasLimitAppliesMultiplierAndCap(t *testing.T) {
+	cfg := GasConfig{Multiplier: 1.5, Max: 100_000}
+	for _, tc := range []struct {
+		estimated uint64
+		want      uint64
+	}{
+		{21000, 31500},
+		{80000, 100_000}, // 乘以系数后超过上限，取上限
+	} {
+		got, err := EstimateGasLimit(context.Background(), stubEstimator{gas: tc.estimated}, cfg, ethereum.CallMsg{})
+		if err != nil || got != tc.want {
+			t.Errorf("estimated %d: limit = %d, %v; want %d", tc.estimated, got, err, tc.want)
+		}
+	}
+
+	if _, err := EstimateGasLimit(context.Background(), stubEstimator{gas: 150_000}, cfg, ethereum.CallMsg{}); !errors.Is(err, ErrGasCapExceeded) {
+		t.Errorf("estimate above cap: err = %v, want ErrGasCapExceeded", err)
+	}
+}
+
+func TestEstimateGasLimitSurfacesRevertReason(t *testing.T) {
+	cfg := GasConfig{Multiplier: 1.2, Max: 1_000_000, FallbackLimit: 300_000}
+	estimator := stubEstimator{err: rpcRevertError{data: encodeRevert(t, "cannot decrement below zero")}}
+
+	_, err := EstimateGasLimit(context.Background(), estimator, cfg, ethereum.CallMsg{})
+	revert, ok := AsRevert(err)
+	if !ok {
+		t.Fatalf("err = %v, want RevertError (no fallback for reverts)", err)
+	}
+	if revert.Reason != "cannot decrement below zero" {
+		t.Errorf("reason = %q", revert.Reason)
+	}
+	if err.Error() != "execution reverted: cannot decrement below zero" {
+		t.Errorf("Error() = %q", err)
+	}
+}
+
+func TestEstimateGasLimitFallback(t *testing.T) {
+	unavailable := stubEstimator{err: errors.New("the method eth_estimateGas does not exist")}
+
+	cfg := GasConfig{Multiplier: 1.2, Max: 1_000_000, FallbackLimit: 300_000}
+	if got, err := EstimateGasLimit(context.Background(), unavailable, cfg, ethereum.CallMsg{}); err != nil || got != 300_000 {
+		t.Errorf("fallback: limit = %d, %v; want 300000", got, err)
+	}
+
+	cfg.FallbackLimit = 0
+	if _, err := EstimateGasLimit(context.Background(), unavailable, cfg, ethereum.CallMsg{}); err == nil {
+		t.Error("no fallback configured: expected error")
+	}
+
+	// 网络错误、5xx 和节点不支持估算时使用回退值
+	cfg.FallbackLimit = 300_000
+	for _, estimateErr := range []error{
+		&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED},
+		fmt.Errorf("post: %w", context.DeadlineExceeded),
+		rpc.HTTPError{StatusCode: http.StatusBadGateway, Status: "502 Bad Gateway"},
+		rpcCodeError{code: -32601, msg: "the method eth_estimateGas is not available"},
+	} {
+		if got, err := EstimateGasLimit(context.Background(), stubEstimator{err: estimateErr}, cfg, ethereum.CallMsg{}); err != nil || got != 300_000 {
+			t.Errorf("%v: limit = %d, %v; want fallback 300000", estimateErr, got, err)
+		}
+	}
+}
+
+func TestEstimateGasLimitDoesNotMaskTransactionErrors(t *testing.T) {
+	cfg := GasConfig{Multiplier: 1.2, Max: 1_000_000, FallbackLimit: 300_000}
+	for _, estimateErr := range []error{
+		errors.New("insufficient funds for gas * price + value"),
+		rpcCodeError{code: -32000, msg: "nonce too low"},
+		rpcCodeError{code: -32602, msg: "invalid argument 0: json: cannot unmarshal hex string"},
+		rpc.HTTPError{StatusCode: http.StatusUnauthorized, Status: "401 Unauthorized"},
+		errors.New("gas required exceeds allowance (30000000)"),
+	} {
+		_, err := EstimateGasLimit(context.Background(), stubEstimator{err: estimateErr}, cfg, ethereum.CallMsg{})
+		if err == nil || !strings.Contains(err.Error(), estimateErr.Error()) {
+			t.Errorf("%v: err = %v, want the estimation error instead of fallback", estimateErr, err)
+		}
+	}
+
+	// 调用方的 ctx 已取消时不回退
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := EstimateGasLimit(ctx, stubEstimator{err: context.Canceled}, cfg, ethereum.CallMsg{}); err == nil {
+		t.Error("canceled context: expected error instead of fallback")
+	}
+}
+
+func TestGasConfigValidate(t *testing.T) {
+	if err := DefaultGasConfig().Validate(); err != nil {
+		t.Errorf("default config: %v", err)
+	}
+	if err := (GasConfig{Multiplier: 0.5, Max: 100, FallbackLimit: 200}).Validate(); err == nil {
+		t.Error("expected errors for multiplier < 1 and fallback above max")
+	}
+}
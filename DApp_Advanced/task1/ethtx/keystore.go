@@ -0,0 +1,49 @@
+package ethtx
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"golang.org/x/term"
+)
+
+// NewKeystoreSigner 解密 go-ethereum 的加密JSON keystore 文件并创建签名器
+func NewKeystoreSigner(path, passphrase string) (Signer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read keystore %s: %w", path, err)
+	}
+	key, err := keystore.DecryptKey(data, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt keystore %s: %w", path, err)
+	}
+	return NewKeySigner(key.PrivateKey), nil
+}
+
+// ReadPassphrase 读取密码：优先使用环境变量 envName，未设置时在终端提示输入（不回显）
+// 标准输入不是终端（例如在服务中运行）且没有设置环境变量时返回错误
+func ReadPassphrase(envName, prompt string) (string, error) {
+	if envName != "" {
+		if passphrase, ok := os.LookupEnv(envName); ok {
+			return passphrase, nil
+		}
+	}
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		if envName == "" {
+			return "", fmt.Errorf("cannot prompt for passphrase: stdin is not a terminal")
+		}
+		return "", fmt.Errorf("environment variable %s is not set and stdin is not a terminal", envName)
+	}
+
+	fmt.Fprint(os.Stderr, prompt)
+	passphrase, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("read passphrase: %w", err)
+	}
+	return strings.TrimRight(string(passphrase), "\r\n"), nil
+}
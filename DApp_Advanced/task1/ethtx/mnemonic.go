@@ -0,0 +1,133 @@
+package ethtx
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	_ "embed"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// DefaultDerivationPath 以太坊第一个账户的 BIP-44 派生路径，与 MetaMask、Ledger 等钱包一致
+const DefaultDerivationPath = "m/44'/60'/0'/0/0"
+
+// bip39English BIP-39 英文词表（与 bitcoin/bips 仓库中的 bip-0039/english.txt 相同）
+//
+//go:embed bip39_english.txt
+var bip39English string
+
+// bip39Index 词表中每个单词的序号
+var bip39Index = func() map[string]int {
+	words := strings.Fields(bip39English)
+	index := make(map[string]int, len(words))
+	for i, word := range words {
+		index[word] = i
+	}
+	return index
+}()
+
+// NewMnemonicSigner 根据 BIP-39 助记词（及可选的助记词密码）按 BIP-44 路径派生私钥并创建签名器
+// path 为空时使用 DefaultDerivationPath
+func NewMnemonicSigner(mnemonic, passphrase, path string) (Signer, error) {
+	if path == "" {
+		path = DefaultDerivationPath
+	}
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid derivation path %q: %w", path, err)
+	}
+
+	words := strings.Fields(mnemonic)
+	if err := validateMnemonic(words); err != nil {
+		return nil, err
+	}
+
+	// BIP-39：助记词经 PBKDF2-HMAC-SHA512 迭代2048次得到64字节种子
+	seed := pbkdf2.Key([]byte(strings.Join(words, " ")), []byte("mnemonic"+passphrase), 2048, 64, sha512.New)
+	key, err := deriveKey(seed, derivationPath)
+	if err != nil {
+		return nil, err
+	}
+	return NewKeySigner(key), nil
+}
+
+// validateMnemonic 校验助记词的单词数、每个单词都在 BIP-39 英文词表中以及校验和，
+// 避免抄错一个单词时静默派生出另一个账户。错误信息中不包含助记词的单词
+func validateMnemonic(words []string) error {
+	switch len(words) {
+	case 12, 15, 18, 21, 24:
+	default:
+		return fmt.Errorf("mnemonic has %d words, want 12, 15, 18, 21 or 24", len(words))
+	}
+
+	// 每个单词11位，前 len*32/3 位是熵，后 len/3 位是熵的 SHA-256 的前几位
+	bits := new(big.Int)
+	for i, word := range words {
+		index, ok := bip39Index[word]
+		if !ok {
+			return fmt.Errorf("mnemonic word %d is not in the BIP-39 English wordlist", i+1)
+		}
+		bits.Lsh(bits, 11).Or(bits, big.NewInt(int64(index)))
+	}
+	checksumBits := uint(len(words) / 3)
+	checksum := new(big.Int).And(bits, big.NewInt(1<<checksumBits-1))
+	entropy := math.PaddedBigBytes(new(big.Int).Rsh(bits, checksumBits), len(words)*4/3)
+
+	hash := sha256.Sum256(entropy)
+	if uint64(hash[0]>>(8-checksumBits)) != checksum.Uint64() {
+		return errors.New("mnemonic checksum mismatch, check the words and their order")
+	}
+	return nil
+}
+
+// deriveKey 按 BIP-32 从种子派生 secp256k1 私钥
+func deriveKey(seed []byte, path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	n := crypto.S256().Params().N
+
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	key, chainCode := sum[:32], sum[32:]
+
+	for _, index := range path {
+		// 硬化派生使用父私钥，普通派生使用压缩格式的父公钥
+		var data []byte
+		if index >= 0x80000000 {
+			data = append([]byte{0}, key...)
+		} else {
+			parent, err := crypto.ToECDSA(key)
+			if err != nil {
+				return nil, err
+			}
+			data = crypto.CompressPubkey(&parent.PublicKey)
+		}
+		data = binary.BigEndian.AppendUint32(data, index)
+
+		mac = hmac.New(sha512.New, chainCode)
+		mac.Write(data)
+		sum = mac.Sum(nil)
+
+		tweak := new(big.Int).SetBytes(sum[:32])
+		if tweak.Cmp(n) >= 0 {
+			return nil, errors.New("invalid derived key, try another index")
+		}
+		child := tweak.Add(tweak, new(big.Int).SetBytes(key))
+		child.Mod(child, n)
+		if child.Sign() == 0 {
+			return nil, errors.New("invalid derived key, try another index")
+		}
+		key, chainCode = math.PaddedBigBytes(child, 32), sum[32:]
+	}
+
+	return crypto.ToECDSA(key)
+}
@@ -0,0 +1,117 @@
+package ethtx
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NonceClient 读取账户nonce所需的节点接口
+type NonceClient interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// NonceManager 在本地为同一账户分配nonce，支持并发发送交易
+// 第一次分配时从节点读取 pending nonce，之后在本地递增；发送失败（未广播）的nonce通过 Release 归还并优先复用，避免nonce空洞
+type NonceManager struct {
+	client  NonceClient
+	account common.Address
+
+	mutex    sync.Mutex
+	synced   bool
+	stale    bool     // 重新同步失败，下次分配时先从节点读取 pending nonce
+	next     uint64   // 下一个未分配过的nonce
+	released []uint64 // 已归还、等待复用的nonce，升序
+}
+
+// NewNonceManager 创建账户 account 的nonce管理器
+func NewNonceManager(client NonceClient, account common.Address) *NonceManager {
+	return &NonceManager{client: client, account: account}
+}
+
+// Next 分配下一个nonce
+func (m *NonceManager) Next(ctx context.Context) (uint64, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if !m.synced || m.stale {
+		pending, err := m.client.PendingNonceAt(ctx, m.account)
+		if err != nil {
+			return 0, fmt.Errorf("get pending nonce: %w", err)
+		}
+		m.apply(pending)
+	}
+	if len(m.released) > 0 {
+		nonce := m.released[0]
+		m.released = m.released[1:]
+		return nonce, nil
+	}
+	nonce := m.next
+	m.next++
+	return nonce, nil
+}
+
+// Release 归还没有广播出去的nonce，下次分配时优先使用
+func (m *NonceManager) Release(nonce uint64) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if !m.synced || nonce >= m.next {
+		return
+	}
+	i := sort.Search(len(m.released), func(i int) bool { return m.released[i] >= nonce })
+	if i < len(m.released) && m.released[i] == nonce {
+		return
+	}
+	m.released = append(m.released, 0)
+	copy(m.released[i+1:], m.released[i:])
+	m.released[i] = nonce
+}
+
+// Resync 从节点读取 pending nonce，节点的nonce更大时（其他程序用同一账户发送了交易）跳到节点的nonce
+// 本地已分配但尚未广播的nonce不会被重复分配
+func (m *NonceManager) Resync(ctx context.Context) error {
+	pending, err := m.client.PendingNonceAt(ctx, m.account)
+	if err != nil {
+		return fmt.Errorf("get pending nonce: %w", err)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.apply(pending)
+	return nil
+}
+
+// apply 按节点的 pending nonce 更新本地状态，调用方需持有锁
+func (m *NonceManager) apply(pending uint64) {
+	if !m.synced || pending > m.next {
+		m.next = pending
+		m.synced = true
+	}
+	m.stale = false
+	// 小于节点nonce的已归还nonce已被使用
+	i := sort.Search(len(m.released), func(i int) bool { return m.released[i] >= pending })
+	m.released = m.released[i:]
+}
+
+// markStale 标记本地状态需要重新同步（Resync 失败时），下次 Next 先从节点读取 pending nonce
+func (m *NonceManager) markStale() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.stale = true
+}
+
+// Reset 丢弃本地状态，下次分配时重新从节点读取。只应在没有正在发送的交易时调用
+func (m *NonceManager) Reset() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.synced = false
+	m.stale = false
+	m.released = nil
+}
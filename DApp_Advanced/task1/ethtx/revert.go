@@ -0,0 +1,80 @@
+package ethtx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// RevertError 合约执行被回滚（require/revert/assert 失败）
+type RevertError struct {
+	Reason string // 解码后的回滚原因，自定义错误等无法解码时为空
+	Data   []byte // 节点返回的原始回滚数据
+}
+
+func (e *RevertError) Error() string {
+	switch {
+	case e.Reason != "":
+		return "execution reverted: " + e.Reason
+	case len(e.Data) > 0:
+		return "execution reverted: " + hexutil.Encode(e.Data)
+	default:
+		return "execution reverted"
+	}
+}
+
+// AsRevert 判断节点返回的错误是否为合约回滚，是则解码其中的回滚原因
+func AsRevert(err error) (*RevertError, bool) {
+	if err == nil {
+		return nil, false
+	}
+	var revert *RevertError
+	if errors.As(err, &revert) {
+		return revert, true
+	}
+
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if data := revertData(dataErr.ErrorData()); data != nil {
+			return NewRevertError(data), true
+		}
+	}
+	if strings.Contains(err.Error(), "execution reverted") {
+		return &RevertError{}, true
+	}
+	return nil, false
+}
+
+// NewRevertError 从回滚数据创建 RevertError，Error(string) 和 Panic(uint256) 会被解码为可读的原因
+func NewRevertError(data []byte) *RevertError {
+	reason, err := abi.UnpackRevert(data)
+	if err != nil {
+		reason = ""
+	}
+	return &RevertError{Reason: reason, Data: data}
+}
+
+// revertData 解析 JSON-RPC 错误中的回滚数据（十六进制字符串）
+func revertData(data interface{}) []byte {
+	s, ok := data.(string)
+	if !ok {
+		return nil
+	}
+	decoded, err := hexutil.Decode(s)
+	if err != nil || len(decoded) == 0 {
+		return nil
+	}
+	return decoded
+}
+
+// wrapRevert 将合约回滚的错误转换为 RevertError，其他错误加上说明后返回
+func wrapRevert(what string, err error) error {
+	if revert, ok := AsRevert(err); ok {
+		return revert
+	}
+	return fmt.Errorf("%s: %w", what, err)
+}
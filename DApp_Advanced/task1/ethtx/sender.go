@@ -0,0 +1,223 @@
+package ethtx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// maxNonceRetries nonce过低时重新分配nonce的最大次数
+const maxNonceRetries = 3
+
+// replacementBumpPercent 替换交易（加速、取消）的手续费至少比原交易高出的百分比，节点交易池要求至少10%
+const replacementBumpPercent = 10
+
+// ErrNonceUsed nonce已被使用：要替换的交易已经上链，或重新同步后节点仍然报告nonce过低
+var ErrNonceUsed = errors.New("nonce already used")
+
+// TxClient 发送交易所需的节点接口，*ethclient.Client 实现了该接口
+type TxClient interface {
+	NonceClient
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+}
+
+// Sender 使用同一账户发送交易：通过 NonceManager 分配nonce，支持并发发送，
+// 节点报告nonce过低时重新同步nonce并重试，并支持加速和取消卡住的交易
+type Sender struct {
+	client  TxClient
+	signer  Signer
+	chainID *big.Int
+	nonces  *NonceManager
+}
+
+// NewSender 创建交易发送器
+func NewSender(client TxClient, signer Signer, chainID *big.Int) *Sender {
+	return &Sender{
+		client:  client,
+		signer:  signer,
+		chainID: chainID,
+		nonces:  NewNonceManager(client, signer.Address()),
+	}
+}
+
+// Address 返回发送账户地址
+func (s *Sender) Address() common.Address {
+	return s.signer.Address()
+}
+
+// Nonces 返回发送器使用的nonce管理器
+func (s *Sender) Nonces() *NonceManager {
+	return s.nonces
+}
+
+// TransactOpts 创建使用发送器签名的 abigen 交易选项，配合 Transact 使用
+func (s *Sender) TransactOpts(ctx context.Context) *bind.TransactOpts {
+	return TransactOpts(ctx, s.signer, s.chainID)
+}
+
+// SendTx 分配nonce，使用 build 创建未签名的交易，签名后发送
+func (s *Sender) SendTx(ctx context.Context, build func(nonce uint64) (*types.Transaction, error)) (*types.Transaction, error) {
+	return s.SendTxRecorded(ctx, build, nil)
+}
+
+// SendTxRecorded 与 SendTx 相同，但在签名后、发送前调用 record（例如先把交易哈希持久化，
+// 程序在发送过程中退出后可以据此查询交易，避免重复发送）；record 返回错误时不发送。
+// nonce过低重建交易时 record 会以新交易再次调用
+func (s *Sender) SendTxRecorded(ctx context.Context, build func(nonce uint64) (*types.Transaction, error), record func(tx *types.Transaction) error) (*types.Transaction, error) {
+	return s.send(ctx, func(nonce uint64) (*types.Transaction, error) {
+		tx, err := build(nonce)
+		if err != nil {
+			return nil, err
+		}
+		signed, err := s.signer.SignTx(ctx, tx, s.chainID)
+		if err != nil || record == nil {
+			return signed, err
+		}
+		if err := record(signed); err != nil {
+			return nil, fmt.Errorf("record transaction: %w", err)
+		}
+		return signed, nil
+	})
+}
+
+// Transact 分配nonce后调用 abigen 绑定的写方法 call 创建并签名交易，再由发送器发送
+// opts 应由 Sender.TransactOpts 创建，其中的 Nonce 和 NoSend 会被覆盖
+func (s *Sender) Transact(ctx context.Context, opts *bind.TransactOpts, call func(*bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+	return s.send(ctx, func(nonce uint64) (*types.Transaction, error) {
+		callOpts := *opts
+		callOpts.Nonce = new(big.Int).SetUint64(nonce)
+		callOpts.NoSend = true
+		return call(&callOpts)
+	})
+}
+
+// send 分配nonce并发送 build 返回的已签名交易；nonce过低时重新同步并用新的nonce重建交易，
+// 节点已有同一笔交易时视为发送成功；无法确定是否已广播（ErrSendUncertain）时同时返回交易和错误；
+// 重试后仍然nonce过低时返回 ErrNonceUsed，不归还nonce；其他失败（节点没有接受交易）归还nonce
+func (s *Sender) send(ctx context.Context, build func(nonce uint64) (*types.Transaction, error)) (*types.Transaction, error) {
+	for attempt := 0; ; attempt++ {
+		nonce, err := s.nonces.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := build(nonce)
+		if err != nil {
+			s.nonces.Release(nonce)
+			return nil, err
+		}
+
+		err = s.client.SendTransaction(ctx, tx)
+		switch {
+		case err == nil:
+			return tx, nil
+		case isAlreadyKnown(err):
+			// 同一笔交易已在交易池中（例如上次发送超时但实际已送达），发送成功；
+			// 重新同步失败不影响这笔交易，只标记nonce管理器在下次分配时重新同步
+			if err := s.nonces.Resync(ctx); err != nil {
+				s.nonces.markStale()
+			}
+			return tx, nil
+		case errors.Is(err, ErrSendUncertain):
+			// 交易可能已经广播：不归还nonce也不重建交易，返回已签名的交易，由调用方等待上链或重新广播
+			return tx, err
+		case isNonceTooLow(err) && attempt < maxNonceRetries:
+			if err := s.nonces.Resync(ctx); err != nil {
+				return nil, err
+			}
+		case isNonceTooLow(err):
+			// nonce已被使用，不能归还；下次分配时重新同步
+			s.nonces.markStale()
+			return nil, fmt.Errorf("send transaction: %w (nonce %d): %v", ErrNonceUsed, nonce, err)
+		default:
+			s.nonces.Release(nonce)
+			return nil, wrapRevert("send transaction", err)
+		}
+	}
+}
+
+// SpeedUp 用相同的nonce重新发送交易 tx，小费和费用上限取 fees 与原交易提高10%后的较大值
+func (s *Sender) SpeedUp(ctx context.Context, tx *types.Transaction, fees *Fees) (*types.Transaction, error) {
+	return s.replace(ctx, tx, tx.To(), tx.Value(), tx.Gas(), tx.Data(), fees)
+}
+
+// Cancel 用相同的nonce向自己发送0 ETH并提高手续费，使交易 tx 不再被打包
+func (s *Sender) Cancel(ctx context.Context, tx *types.Transaction, fees *Fees) (*types.Transaction, error) {
+	self := s.Address()
+	return s.replace(ctx, tx, &self, new(big.Int), params.TxGas, nil, fees)
+}
+
+// replace 发送与 tx 使用相同nonce、手续费更高的替换交易
+func (s *Sender) replace(ctx context.Context, tx *types.Transaction, to *common.Address, value *big.Int, gas uint64, data []byte, fees *Fees) (*types.Transaction, error) {
+	bumped := BumpFees(tx, fees)
+	replacement, err := s.signer.SignTx(ctx, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   s.chainID,
+		Nonce:     tx.Nonce(),
+		GasTipCap: bumped.TipCap,
+		GasFeeCap: bumped.FeeCap,
+		Gas:       gas,
+		To:        to,
+		Value:     value,
+		Data:      data,
+	}), s.chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.client.SendTransaction(ctx, replacement)
+	switch {
+	case err == nil || isAlreadyKnown(err):
+		return replacement, nil
+	case isNonceTooLow(err):
+		return nil, fmt.Errorf("replace transaction %s: %w (nonce %d)", tx.Hash().Hex(), ErrNonceUsed, tx.Nonce())
+	case errors.Is(err, ErrSendUncertain):
+		return replacement, fmt.Errorf("replace transaction %s: %w", tx.Hash().Hex(), err)
+	default:
+		return nil, fmt.Errorf("replace transaction %s: %w", tx.Hash().Hex(), err)
+	}
+}
+
+// BumpFees 计算替换交易 tx 的手续费：原交易的小费和费用上限各提高10%（向上取整），
+// 与 fees（当前建议的手续费，可以为nil）取较大值
+func BumpFees(tx *types.Transaction, fees *Fees) *Fees {
+	bumped := &Fees{
+		TipCap: bumpPercent(tx.GasTipCap(), replacementBumpPercent),
+		FeeCap: bumpPercent(tx.GasFeeCap(), replacementBumpPercent),
+	}
+	if fees != nil {
+		if fees.TipCap.Cmp(bumped.TipCap) > 0 {
+			bumped.TipCap = new(big.Int).Set(fees.TipCap)
+		}
+		if fees.FeeCap.Cmp(bumped.FeeCap) > 0 {
+			bumped.FeeCap = new(big.Int).Set(fees.FeeCap)
+		}
+	}
+	if bumped.FeeCap.Cmp(bumped.TipCap) < 0 {
+		bumped.FeeCap = new(big.Int).Set(bumped.TipCap)
+	}
+	return bumped
+}
+
+// bumpPercent 返回 x 提高 p% 后向上取整的值
+func bumpPercent(x *big.Int, p int64) *big.Int {
+	result := new(big.Int).Mul(x, big.NewInt(100+p))
+	result.Add(result, big.NewInt(99))
+	return result.Quo(result, big.NewInt(100))
+}
+
+// isNonceTooLow 判断发送失败是否因为nonce已被使用
+func isNonceTooLow(err error) bool {
+	return strings.Contains(err.Error(), "nonce too low")
+}
+
+// isAlreadyKnown 判断发送失败是否因为节点已有同一笔交易
+func isAlreadyKnown(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "already known") || strings.Contains(msg, "known transaction")
+}
@@ -0,0 +1,320 @@
+package ethtx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// stubTxClient 模拟节点交易池：记录收到的交易，nonce小于 pending 的交易返回 nonce too low
+type stubTxClient struct {
+	mu      sync.Mutex
+	pending uint64
+	sent    []*types.Transaction
+	sendErr error // 不为nil时所有发送返回该错误
+}
+
+func (c *stubTxClient) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.pending, nil
+}
+
+func (c *stubTxClient) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.sendErr != nil {
+		return c.sendErr
+	}
+	if tx.Nonce() < c.pending {
+		return errors.New("nonce too low: next nonce 7, tx nonce 5")
+	}
+	c.sent = append(c.sent, tx)
+	if tx.Nonce() >= c.pending {
+		c.pending = tx.Nonce() + 1
+	}
+	return nil
+}
+
+func newTestSender(t *testing.T, client TxClient) *Sender {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewSender(client, NewKeySigner(key), testChainID)
+}
+
+func transferTo(to common.Address) func(uint64) (*types.Transaction, error) {
+	return func(nonce uint64) (*types.Transaction, error) {
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:   testChainID,
+			Nonce:     nonce,
+			GasTipCap: big.NewInt(1e9),
+			GasFeeCap: big.NewInt(2e9),
+			Gas:       21000,
+			To:        &to,
+			Value:     big.NewInt(1),
+		}), nil
+	}
+}
+
+func TestNonceManagerConcurrentNext(t *testing.T) {
+	manager := NewNonceManager(&stubTxClient{pending: 5}, common.Address{})
+
+	const n = 20
+	nonces := make(chan uint64, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nonce, err := manager.Next(context.Background())
+			if err != nil {
+				t.Error(err)
+			}
+			nonces <- nonce
+		}()
+	}
+	wg.Wait()
+	close(nonces)
+
+	seen := make(map[uint64]bool)
+	for nonce := range nonces {
+		if seen[nonce] || nonce < 5 || nonce >= 5+n {
+			t.Errorf("unexpected nonce %d", nonce)
+		}
+		seen[nonce] = true
+	}
+}
+
+func TestNonceManagerReusesReleasedNonces(t *testing.T) {
+	manager := NewNonceManager(&stubTxClient{pending: 0}, common.Address{})
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		manager.Next(ctx)
+	}
+	manager.Release(1)
+	manager.Release(0)
+
+	for _, want := range []uint64{0, 1, 3} {
+		if got, _ := manager.Next(ctx); got != want {
+			t.Errorf("Next() = %d, want %d", got, want)
+		}
+	}
+}
+
+func TestSenderResyncsOnNonceTooLow(t *testing.T) {
+	client := &stubTxClient{pending: 3}
+	sender := newTestSender(t, client)
+	to := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+
+	if _, err := sender.SendTx(context.Background(), transferTo(to)); err != nil {
+		t.Fatal(err)
+	}
+	// 其他程序用同一账户发送了交易，节点nonce跳到 7
+	client.pending = 7
+	tx, err := sender.SendTx(context.Background(), transferTo(to))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Nonce() != 7 {
+		t.Errorf("nonce after resync = %d, want 7", tx.Nonce())
+	}
+	if next, _ := sender.Nonces().Next(context.Background()); next != 8 {
+		t.Errorf("next nonce = %d, want 8", next)
+	}
+}
+
+func TestSenderTreatsAlreadyKnownAsSent(t *testing.T) {
+	client := &stubTxClient{sendErr: errors.New("already known")}
+	sender := newTestSender(t, client)
+
+	tx, err := sender.SendTx(context.Background(), transferTo(common.Address{}))
+	if err != nil || tx == nil {
+		t.Fatalf("tx = %v, err = %v; want success", tx, err)
+	}
+}
+
+// flakyNonceClient 第一次之后读取 pending nonce 失败
+type flakyNonceClient struct {
+	stubTxClient
+	reads int
+}
+
+func (c *flakyNonceClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	if c.reads++; c.reads > 1 {
+		return 0, errors.New("connection refused")
+	}
+	return c.stubTxClient.PendingNonceAt(ctx, account)
+}
+
+func TestSenderTreatsAlreadyKnownAsSentWhenResyncFails(t *testing.T) {
+	client := &flakyNonceClient{stubTxClient: stubTxClient{sendErr: errors.New("already known")}}
+	sender := newTestSender(t, client)
+
+	// 交易已在交易池中，重新同步失败不能让调用方以为发送失败而重发
+	tx, err := sender.SendTx(context.Background(), transferTo(common.Address{}))
+	if err != nil || tx == nil {
+		t.Fatalf("tx = %v, err = %v; want success", tx, err)
+	}
+	// 下次分配时重新同步
+	if _, err := sender.Nonces().Next(context.Background()); err == nil {
+		t.Error("Next() after failed resync: want pending nonce read")
+	}
+}
+
+func TestSenderDoesNotReleaseNonceTooLow(t *testing.T) {
+	// 节点的 pending nonce 落后，每个nonce都报告过低
+	client := &stubTxClient{sendErr: errors.New("nonce too low")}
+	sender := newTestSender(t, client)
+
+	_, err := sender.SendTx(context.Background(), transferTo(common.Address{}))
+	if !errors.Is(err, ErrNonceUsed) {
+		t.Fatalf("err = %v, want ErrNonceUsed", err)
+	}
+	// 节点报告已被使用的nonce不会归还复用
+	if next, _ := sender.Nonces().Next(context.Background()); next <= maxNonceRetries {
+		t.Errorf("next nonce = %d, want a nonce after the %d used ones", next, maxNonceRetries+1)
+	}
+}
+
+func TestSenderReleasesNonceOnFailure(t *testing.T) {
+	client := &stubTxClient{sendErr: errors.New("insufficient funds for gas * price + value")}
+	sender := newTestSender(t, client)
+
+	if _, err := sender.SendTx(context.Background(), transferTo(common.Address{})); err == nil {
+		t.Fatal("expected error")
+	}
+	client.sendErr = nil
+	tx, err := sender.SendTx(context.Background(), transferTo(common.Address{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Nonce() != 0 {
+		t.Errorf("nonce = %d, want released nonce 0 to be reused", tx.Nonce())
+	}
+}
+
+func TestSenderKeepsNonceWhenSendIsUncertain(t *testing.T) {
+	client := &stubTxClient{sendErr: fmt.Errorf("send transaction: %w: timeout", ErrSendUncertain)}
+	sender := newTestSender(t, client)
+
+	// 交易可能已经广播：返回已签名的交易，不重建交易
+	tx, err := sender.SendTx(context.Background(), transferTo(common.Address{}))
+	if !errors.Is(err, ErrSendUncertain) || tx == nil || tx.Nonce() != 0 {
+		t.Fatalf("tx = %v, err = %v; want nonce 0 transaction and ErrSendUncertain", tx, err)
+	}
+	// nonce没有归还，下一笔交易不会用同一nonce替换可能已广播的交易
+	client.sendErr = nil
+	next, err := sender.SendTx(context.Background(), transferTo(common.Address{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.Nonce() != 1 {
+		t.Errorf("nonce = %d, want 1", next.Nonce())
+	}
+}
+
+func TestSenderRecordsBeforeSending(t *testing.T) {
+	client := &stubTxClient{}
+	sender := newTestSender(t, client)
+	to := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+
+	var recorded []common.Hash
+	tx, err := sender.SendTxRecorded(context.Background(), transferTo(to), func(tx *types.Transaction) error {
+		// 记录时交易已签名但尚未发送
+		if len(client.sent) != 0 {
+			t.Error("transaction sent before it was recorded")
+		}
+		recorded = append(recorded, tx.Hash())
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recorded) != 1 || recorded[0] != tx.Hash() {
+		t.Errorf("recorded %v, want the sent transaction %s", recorded, tx.Hash().Hex())
+	}
+
+	// 记录失败时不发送，nonce被释放
+	_, err = sender.SendTxRecorded(context.Background(), transferTo(to), func(*types.Transaction) error {
+		return errors.New("disk full")
+	})
+	if err == nil || len(client.sent) != 1 {
+		t.Fatalf("err = %v, sent %d; want record error and no send", err, len(client.sent))
+	}
+	if tx, err := sender.SendTx(context.Background(), transferTo(to)); err != nil || tx.Nonce() != 1 {
+		t.Errorf("nonce after failed record = %v, %v; want 1", tx, err)
+	}
+}
+
+func TestSenderTransactUsesManagedNonce(t *testing.T) {
+	client := &stubTxClient{pending: 4}
+	sender := newTestSender(t, client)
+	opts := sender.TransactOpts(context.Background())
+
+	tx, err := sender.Transact(context.Background(), opts, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		if !opts.NoSend {
+			t.Error("binding would broadcast the transaction itself")
+		}
+		tx, _ := transferTo(common.Address{})(opts.Nonce.Uint64())
+		return opts.Signer(opts.From, tx)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Nonce() != 4 || len(client.sent) != 1 {
+		t.Errorf("nonce = %d, sent %d transactions", tx.Nonce(), len(client.sent))
+	}
+}
+
+func TestSenderSpeedUpAndCancel(t *testing.T) {
+	client := &stubTxClient{}
+	sender := newTestSender(t, client)
+	to := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+
+	tx, err := sender.SendTx(context.Background(), transferTo(to))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.pending = tx.Nonce() // 原交易仍在交易池中等待打包
+
+	faster, err := sender.SpeedUp(context.Background(), tx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if faster.Nonce() != tx.Nonce() || *faster.To() != to || faster.Value().Cmp(tx.Value()) != 0 {
+		t.Error("speed-up changed the transaction")
+	}
+	if faster.GasTipCap().Int64() != 1.1e9 || faster.GasFeeCap().Int64() != 2.2e9 {
+		t.Errorf("speed-up fees = %s/%s, want +10%%", faster.GasTipCap(), faster.GasFeeCap())
+	}
+
+	client.pending = tx.Nonce()
+	suggested := &Fees{TipCap: big.NewInt(3e9), FeeCap: big.NewInt(10e9)}
+	cancel, err := sender.Cancel(context.Background(), faster, suggested)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cancel.Nonce() != tx.Nonce() || *cancel.To() != sender.Address() || cancel.Value().Sign() != 0 || cancel.Gas() != 21000 {
+		t.Error("cancel is not a zero-value transfer to self with the same nonce")
+	}
+	if cancel.GasTipCap().Cmp(suggested.TipCap) != 0 || cancel.GasFeeCap().Cmp(suggested.FeeCap) != 0 {
+		t.Errorf("cancel fees = %s/%s, want suggested fees", cancel.GasTipCap(), cancel.GasFeeCap())
+	}
+
+	// 原交易已经上链
+	client.pending = tx.Nonce() + 1
+	if _, err := sender.Cancel(context.Background(), tx, nil); !errors.Is(err, ErrNonceUsed) {
+		t.Errorf("cancel after mined: err = %v, want ErrNonceUsed", err)
+	}
+}
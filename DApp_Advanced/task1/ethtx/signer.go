@@ -0,0 +1,55 @@
+// Package ethtx 提供 task1 中各个程序共用的交易工具：可插拔的交易签名器等
+package ethtx
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signer 交易签名器，屏蔽私钥的来源（明文私钥、加密keystore、助记词、外部签名服务）
+type Signer interface {
+	// Address 返回签名账户的地址
+	Address() common.Address
+	// SignTx 使用指定链ID签名交易，返回签名后的交易
+	SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// keySigner 使用内存中的私钥签名
+type keySigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+// NewKeySigner 使用私钥创建签名器
+func NewKeySigner(key *ecdsa.PrivateKey) Signer {
+	return &keySigner{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+func (s *keySigner) Address() common.Address {
+	return s.address
+}
+
+func (s *keySigner) SignTx(_ context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), s.key)
+}
+
+// TransactOpts 创建使用 signer 签名的 abigen 交易选项，用于调用合约绑定的写方法
+func TransactOpts(ctx context.Context, signer Signer, chainID *big.Int) *bind.TransactOpts {
+	from := signer.Address()
+	return &bind.TransactOpts{
+		From:    from,
+		Context: ctx,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != from {
+				return nil, bind.ErrNotAuthorized
+			}
+			return signer.SignTx(ctx, tx, chainID)
+		},
+	}
+}
@@ -0,0 +1,125 @@
+package ethtx
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// 签名器类型
+const (
+	SignerTypeEnv      = "env"      // 从环境变量读取十六进制私钥
+	SignerTypeKeystore = "keystore" // 加密JSON keystore文件，密码来自环境变量或终端输入
+	SignerTypeMnemonic = "mnemonic" // 从环境变量读取助记词，按BIP-44路径派生私钥
+	SignerTypeExternal = "external" // 外部签名服务（例如 Clef）
+)
+
+// SignerConfig 签名器配置，私钥、密码和助记词本身只从环境变量读取，不写入配置文件
+type SignerConfig struct {
+	Type           string `yaml:"type"`            // 签名器类型
+	PrivateKeyEnv  string `yaml:"private_key_env"` // env：保存十六进制私钥的环境变量名
+	KeystorePath   string `yaml:"keystore_path"`   // keystore：keystore文件路径
+	PassphraseEnv  string `yaml:"passphrase_env"`  // keystore：保存密码的环境变量名，未设置时在终端提示输入；mnemonic：可选的助记词密码
+	MnemonicEnv    string `yaml:"mnemonic_env"`    // mnemonic：保存助记词的环境变量名
+	DerivationPath string `yaml:"derivation_path"` // mnemonic：派生路径，默认 m/44'/60'/0'/0/0
+	ExternalURL    string `yaml:"external_url"`    // external：签名服务地址，例如 http://localhost:8550
+	Address        string `yaml:"address"`         // external：签名账户地址
+}
+
+// DefaultSignerConfig 返回默认的签名器配置：从环境变量 PRIVATE_KEY 读取私钥
+func DefaultSignerConfig() SignerConfig {
+	return SignerConfig{
+		Type:          SignerTypeEnv,
+		PrivateKeyEnv: "PRIVATE_KEY",
+	}
+}
+
+// Validate 校验签名器配置，只检查配置本身，不读取私钥
+func (c SignerConfig) Validate() error {
+	var errs []error
+	switch c.Type {
+	case SignerTypeEnv:
+		if c.PrivateKeyEnv == "" {
+			errs = append(errs, errors.New("private_key_env: required for signer type env"))
+		}
+	case SignerTypeKeystore:
+		if c.KeystorePath == "" {
+			errs = append(errs, errors.New("keystore_path: required for signer type keystore"))
+		}
+	case SignerTypeMnemonic:
+		if c.MnemonicEnv == "" {
+			errs = append(errs, errors.New("mnemonic_env: required for signer type mnemonic"))
+		}
+		if c.DerivationPath != "" {
+			if _, err := accounts.ParseDerivationPath(c.DerivationPath); err != nil {
+				errs = append(errs, fmt.Errorf("derivation_path: %w", err))
+			}
+		}
+	case SignerTypeExternal:
+		if c.ExternalURL == "" {
+			errs = append(errs, errors.New("external_url: required for signer type external"))
+		}
+		if !common.IsHexAddress(c.Address) {
+			errs = append(errs, fmt.Errorf("address: %q is not a valid address", c.Address))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("type: unknown signer type %q (want %s, %s, %s or %s)",
+			c.Type, SignerTypeEnv, SignerTypeKeystore, SignerTypeMnemonic, SignerTypeExternal))
+	}
+	return errors.Join(errs...)
+}
+
+// NewSigner 根据配置创建签名器
+func NewSigner(c SignerConfig) (Signer, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch c.Type {
+	case SignerTypeEnv:
+		hexKey, err := requireEnv(c.PrivateKeyEnv)
+		if err != nil {
+			return nil, err
+		}
+		key, err := crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: invalid private key: %w", c.PrivateKeyEnv, err)
+		}
+		return NewKeySigner(key), nil
+
+	case SignerTypeKeystore:
+		passphrase, err := ReadPassphrase(c.PassphraseEnv, fmt.Sprintf("请输入 %s 的密码: ", c.KeystorePath))
+		if err != nil {
+			return nil, err
+		}
+		return NewKeystoreSigner(c.KeystorePath, passphrase)
+
+	case SignerTypeMnemonic:
+		mnemonic, err := requireEnv(c.MnemonicEnv)
+		if err != nil {
+			return nil, err
+		}
+		var passphrase string
+		if c.PassphraseEnv != "" {
+			passphrase = os.Getenv(c.PassphraseEnv)
+		}
+		return NewMnemonicSigner(mnemonic, passphrase, c.DerivationPath)
+
+	default: // SignerTypeExternal
+		return NewExternalSigner(c.ExternalURL, common.HexToAddress(c.Address))
+	}
+}
+
+// requireEnv 读取必须设置的环境变量
+func requireEnv(name string) (string, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return "", fmt.Errorf("environment variable %s is not set", name)
+	}
+	return value, nil
+}
@@ -0,0 +1,236 @@
+package ethtx
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// anvil/hardhat 默认助记词及其派生的前两个账户
+const testMnemonic = "test test test test test test test test test test test junk"
+
+var (
+	testAccount0 = common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	testAccount1 = common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	testChainID  = big.NewInt(11155111)
+)
+
+func newTestTx() *types.Transaction {
+	to := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   testChainID,
+		Nonce:     1,
+		GasTipCap: big.NewInt(1e9),
+		GasFeeCap: big.NewInt(2e9),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(1),
+	})
+}
+
+// assertSignedBy 检查交易的签名者
+func assertSignedBy(t *testing.T, tx *types.Transaction, want common.Address) {
+	t.Helper()
+	from, err := types.Sender(types.LatestSignerForChainID(testChainID), tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from != want {
+		t.Fatalf("signed by %s, want %s", from.Hex(), want.Hex())
+	}
+}
+
+func TestMnemonicSignerDerivesBIP44Accounts(t *testing.T) {
+	for path, want := range map[string]common.Address{
+		"":                 testAccount0,
+		"m/44'/60'/0'/0/1": testAccount1,
+	} {
+		signer, err := NewMnemonicSigner(testMnemonic, "", path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if signer.Address() != want {
+			t.Errorf("path %q: address = %s, want %s", path, signer.Address().Hex(), want.Hex())
+		}
+	}
+
+	if _, err := NewMnemonicSigner("test test test", "", ""); err == nil {
+		t.Error("expected error for short mnemonic")
+	}
+}
+
+func TestMnemonicSignerValidatesChecksum(t *testing.T) {
+	// BIP-39 的测试向量
+	for _, mnemonic := range []string{
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		"legal winner thank year wave sausage worth useful legal winner thank yellow",
+		"letter advice cage absurd amount doctor acoustic avoid letter advice cage above",
+		"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong",
+		"jelly better achieve collect unaware mountain thought cargo oxygen act hood bridge",
+		"void come effort suffer camp survey warrior heavy shoot primary clutch crush open amazing screen patrol group space point ten exist slush involve unfold",
+	} {
+		if _, err := NewMnemonicSigner(mnemonic, "", ""); err != nil {
+			t.Errorf("%q: %v", mnemonic, err)
+		}
+	}
+
+	for name, tc := range map[string]struct{ mnemonic, want string }{
+		"bad checksum":       {"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon", "checksum mismatch"},
+		"swapped words":      {"test test test test test test test test test test junk test", "checksum mismatch"},
+		"unknown word":       {"test test test test test test test test test test tset junk", "word 11 is not in the BIP-39"},
+		"uppercase word":     {"Test test test test test test test test test test test junk", "word 1 is not in the BIP-39"},
+		"bad 24-word phrase": {strings.Repeat("zoo ", 24), "checksum mismatch"},
+	} {
+		_, err := NewMnemonicSigner(tc.mnemonic, "", "")
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: err = %v, want %q", name, err, tc.want)
+		}
+	}
+}
+
+func TestKeystoreSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted, err := keystore.EncryptKey(&keystore.Key{
+		Address:    crypto.PubkeyToAddress(key.PublicKey),
+		PrivateKey: key,
+	}, "secret", keystore.LightScryptN, keystore.LightScryptP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "key.json")
+	if err := os.WriteFile(path, encrypted, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewKeystoreSigner(path, "wrong"); err == nil {
+		t.Fatal("expected error for wrong passphrase")
+	}
+
+	t.Setenv("TEST_KEYSTORE_PASSPHRASE", "secret")
+	signer, err := NewSigner(SignerConfig{Type: SignerTypeKeystore, KeystorePath: path, PassphraseEnv: "TEST_KEYSTORE_PASSPHRASE"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := signer.SignTx(context.Background(), newTestTx(), testChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSignedBy(t, signed, crypto.PubkeyToAddress(key.PublicKey))
+}
+
+func TestTransactOptsRejectsOtherAccounts(t *testing.T) {
+	signer, err := NewMnemonicSigner(testMnemonic, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := TransactOpts(context.Background(), signer, testChainID)
+	if opts.From != testAccount0 {
+		t.Fatalf("From = %s", opts.From.Hex())
+	}
+
+	signed, err := opts.Signer(testAccount0, newTestTx())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSignedBy(t, signed, testAccount0)
+
+	if _, err := opts.Signer(testAccount1, newTestTx()); err != bind.ErrNotAuthorized {
+		t.Errorf("signing for another account: err = %v, want ErrNotAuthorized", err)
+	}
+}
+
+func TestSignerConfigValidate(t *testing.T) {
+	for name, cfg := range map[string]SignerConfig{
+		"unknown type":          {Type: "plaintext"},
+		"env without variable":  {Type: SignerTypeEnv},
+		"keystore without path": {Type: SignerTypeKeystore},
+		"bad derivation path":   {Type: SignerTypeMnemonic, MnemonicEnv: "MNEMONIC", DerivationPath: "m/x"},
+		"external bad address":  {Type: SignerTypeExternal, ExternalURL: "http://localhost:8550", Address: "0x12"},
+	} {
+		if err := cfg.Validate(); err == nil {
+			t.Errorf("%s: expected validation error", name)
+		}
+	}
+	if err := DefaultSignerConfig().Validate(); err != nil {
+		t.Errorf("default config: %v", err)
+	}
+}
+
+// stubClef 模拟 Clef 的 account_* JSON-RPC 接口
+type stubClef struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s *stubClef) Version() string {
+	return "7.0.0"
+}
+
+func (s *stubClef) List() []common.Address {
+	return []common.Address{crypto.PubkeyToAddress(s.key.PublicKey)}
+}
+
+type stubSignResult struct {
+	Raw hexutil.Bytes      `json:"raw"`
+	Tx  *types.Transaction `json:"tx"`
+}
+
+func (s *stubClef) SignTransaction(args apitypes.SendTxArgs, _ *string) (*stubSignResult, error) {
+	tx, err := args.ToTransaction()
+	if err != nil {
+		return nil, err
+	}
+	signed, err := types.SignTx(tx, types.LatestSignerForChainID(args.ChainID.ToInt()), s.key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signed.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &stubSignResult{Raw: raw, Tx: signed}, nil
+}
+
+func TestExternalSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := rpc.NewServer()
+	if err := server.RegisterName("account", &stubClef{key: key}); err != nil {
+		t.Fatal(err)
+	}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	if _, err := NewExternalSigner(httpServer.URL, testAccount0); err == nil {
+		t.Fatal("expected error for an account the signer does not manage")
+	}
+
+	signer, err := NewSigner(SignerConfig{Type: SignerTypeExternal, ExternalURL: httpServer.URL, Address: address.Hex()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := signer.SignTx(context.Background(), newTestTx(), testChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSignedBy(t, signed, address)
+}
@@ -0,0 +1,59 @@
+package ethtx
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// EtherDecimals ETH 与 wei 之间的小数位数
+const EtherDecimals = 18
+
+// decimalPattern 金额只接受普通的十进制写法，拒绝 0x/0b 前缀、下划线分隔、正负号和科学计数法
+var decimalPattern = regexp.MustCompile(`^\d+(\.\d+)?$`)
+
+// ParseUnits 将带小数的金额（例如 "1.5"）按 decimals 位小数转换为最小单位的整数，
+// 用于 ETH（18位）和 ERC-20 代币（由合约的 decimals() 决定）
+func ParseUnits(s string, decimals uint8) (*big.Int, error) {
+	amount := strings.TrimSpace(s)
+	if !decimalPattern.MatchString(amount) {
+		return nil, fmt.Errorf("%q is not a non-negative decimal amount", s)
+	}
+	whole, fraction, _ := strings.Cut(amount, ".")
+	// 小数末尾的0不影响数值，只检查有效的小数位数
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) > int(decimals) {
+		return nil, fmt.Errorf("%q has more than %d decimal places", s, decimals)
+	}
+	value, _ := new(big.Int).SetString(whole+fraction+strings.Repeat("0", int(decimals)-len(fraction)), 10)
+	return value, nil
+}
+
+// FormatUnits 将最小单位的整数按 decimals 位小数格式化，去掉小数末尾的0
+func FormatUnits(value *big.Int, decimals uint8) string {
+	if value == nil {
+		return "0"
+	}
+	s := new(big.Rat).SetFrac(value, unitScale(decimals)).FloatString(int(decimals))
+	if decimals == 0 {
+		return s
+	}
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// ParseEther 将 ETH 金额转换为 wei
+func ParseEther(s string) (*big.Int, error) {
+	return ParseUnits(s, EtherDecimals)
+}
+
+// FormatEther 将 wei 格式化为 ETH
+func FormatEther(wei *big.Int) string {
+	return FormatUnits(wei, EtherDecimals)
+}
+
+// unitScale 返回 10^decimals
+func unitScale(decimals uint8) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+}
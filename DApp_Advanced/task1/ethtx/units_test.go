@@ -0,0 +1,69 @@
+package ethtx
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestParseUnits(t *testing.T) {
+	tests := []struct {
+		in       string
+		decimals uint8
+		want     string
+		wantErr  bool
+	}{
+		{"1", 18, "1000000000000000000", false},
+		{"0.01", 18, "10000000000000000", false},
+		{"1.5", 6, "1500000", false},
+		{"42", 0, "42", false},
+		{"0.000001", 6, "1", false},
+		{"0.0000001", 6, "", true},
+		{"1.5", 0, "", true},
+		{"-1", 18, "", true},
+		{"1e3", 18, "", true},
+		{"1.50", 1, "15", false},
+		{" 2 ", 0, "2", false},
+		{"0x10", 18, "", true},
+		{"1_000", 18, "", true},
+		{"+1", 18, "", true},
+		{"0b1", 18, "", true},
+		{"1.", 18, "", true},
+		{".5", 18, "", true},
+		{"abc", 18, "", true},
+		{"", 18, "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseUnits(tt.in, tt.decimals)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseUnits(%q, %d) error = %v, wantErr %v", tt.in, tt.decimals, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got.String() != tt.want {
+			t.Errorf("ParseUnits(%q, %d) = %s, want %s", tt.in, tt.decimals, got, tt.want)
+		}
+	}
+}
+
+func TestFormatUnits(t *testing.T) {
+	tests := []struct {
+		value    string
+		decimals uint8
+		want     string
+	}{
+		{"1000000000000000000", 18, "1"},
+		{"10000000000000000", 18, "0.01"},
+		{"1500000", 6, "1.5"},
+		{"100", 0, "100"},
+		{"0", 18, "0"},
+		{"1", 18, "0.000000000000000001"},
+	}
+	for _, tt := range tests {
+		value, _ := new(big.Int).SetString(tt.value, 10)
+		if got := FormatUnits(value, tt.decimals); got != tt.want {
+			t.Errorf("FormatUnits(%s, %d) = %q, want %q", tt.value, tt.decimals, got, tt.want)
+		}
+	}
+	if got := FormatEther(nil); got != "0" {
+		t.Errorf("FormatEther(nil) = %q, want 0", got)
+	}
+}
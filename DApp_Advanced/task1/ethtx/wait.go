@@ -0,0 +1,148 @@
+package ethtx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// receiptPollInterval 查询交易回执的间隔
+var receiptPollInterval = 2 * time.Second
+
+var (
+	// ErrTxFailed 交易已上链但执行失败（receipt.Status 为0），通常同时包含 *RevertError
+	ErrTxFailed = errors.New("transaction failed")
+	// ErrReplaced 交易的nonce已被另一笔交易（加速、取消或其他程序发送的交易）使用，该交易不会再上链
+	ErrReplaced = errors.New("transaction replaced")
+)
+
+// ReceiptClient 等待交易上链所需的节点接口，*ethclient.Client 实现了该接口
+type ReceiptClient interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// WaitMined 等待交易上链并获得 confirmations 个确认（包含交易所在区块，0和1都表示上链即返回）
+// 超时和取消由 ctx 控制；交易执行失败时返回回执和包含 ErrTxFailed 及回滚原因的错误；
+// 交易的nonce被另一笔交易使用时返回 ErrReplaced。等待期间发生重组时会继续等待交易重新上链
+func WaitMined(ctx context.Context, client ReceiptClient, tx *types.Transaction, confirmations uint64) (*types.Receipt, error) {
+	from, err := txSender(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	for {
+		receipt, err := client.TransactionReceipt(ctx, tx.Hash())
+		switch {
+		case err == nil:
+			confirmed, err := hasConfirmations(ctx, client, receipt, confirmations)
+			if err != nil {
+				lastErr = err
+				break
+			}
+			if confirmed {
+				return receipt, checkStatus(ctx, client, tx, from, receipt)
+			}
+
+		case errors.Is(err, ethereum.NotFound):
+			replaced, err := isReplaced(ctx, client, tx, from)
+			if err != nil {
+				lastErr = err
+				break
+			}
+			if replaced {
+				return nil, fmt.Errorf("%w: nonce %d of %s was used by another transaction", ErrReplaced, tx.Nonce(), from.Hex())
+			}
+
+		default:
+			lastErr = err // 网络错误等，继续重试直到 ctx 结束
+		}
+
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return nil, fmt.Errorf("wait for transaction %s: %w (last error: %v)", tx.Hash().Hex(), ctx.Err(), lastErr)
+			}
+			return nil, fmt.Errorf("wait for transaction %s: %w", tx.Hash().Hex(), ctx.Err())
+		case <-time.After(receiptPollInterval):
+		}
+	}
+}
+
+// txSender 从签名中恢复交易的发送方
+func txSender(tx *types.Transaction) (common.Address, error) {
+	var signer types.Signer = types.HomesteadSigner{}
+	if tx.Protected() {
+		signer = types.LatestSignerForChainID(tx.ChainId())
+	}
+	from, err := types.Sender(signer, tx)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("recover transaction sender: %w", err)
+	}
+	return from, nil
+}
+
+// hasConfirmations 判断交易所在区块是否已有足够的确认
+func hasConfirmations(ctx context.Context, client ReceiptClient, receipt *types.Receipt, confirmations uint64) (bool, error) {
+	if confirmations <= 1 {
+		return true, nil
+	}
+	head, err := client.BlockNumber(ctx)
+	if err != nil {
+		return false, fmt.Errorf("get block number: %w", err)
+	}
+	mined := receipt.BlockNumber.Uint64()
+	return head >= mined && head-mined+1 >= confirmations, nil
+}
+
+// isReplaced 判断交易的nonce是否已被使用。回执查询和nonce查询之间交易可能刚好上链，
+// 所以nonce已被使用时再查一次回执，确认不是这笔交易上链
+func isReplaced(ctx context.Context, client ReceiptClient, tx *types.Transaction, from common.Address) (bool, error) {
+	nonce, err := client.NonceAt(ctx, from, nil)
+	if err != nil {
+		return false, fmt.Errorf("get nonce: %w", err)
+	}
+	if nonce <= tx.Nonce() {
+		return false, nil
+	}
+	_, err = client.TransactionReceipt(ctx, tx.Hash())
+	switch {
+	case err == nil:
+		return false, nil
+	case errors.Is(err, ethereum.NotFound):
+		return true, nil
+	default:
+		return false, err
+	}
+}
+
+// checkStatus 检查回执状态，交易失败时在上一个区块的状态上重放交易以获得回滚原因
+func checkStatus(ctx context.Context, client ReceiptClient, tx *types.Transaction, from common.Address, receipt *types.Receipt) error {
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		return nil
+	}
+
+	msg := ethereum.CallMsg{
+		From:  from,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+	parent := new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1))
+	_, err := client.CallContract(ctx, msg, parent)
+	if revert, ok := AsRevert(err); ok {
+		return fmt.Errorf("%w in block %d: %w", ErrTxFailed, receipt.BlockNumber, revert)
+	}
+	// 重放成功或因其他原因失败（例如gas耗尽、状态已变化），无法得到回滚原因
+	return fmt.Errorf("%w in block %d (gas used %d of %d)", ErrTxFailed, receipt.BlockNumber, receipt.GasUsed, tx.Gas())
+}
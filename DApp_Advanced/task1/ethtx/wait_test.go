@@ -0,0 +1,135 @@
+package ethtx
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// stubReceiptClient 模拟节点：每次查询区块号时出一个新区块，receipt 为 nil 时回执不存在
+type stubReceiptClient struct {
+	mu        sync.Mutex
+	head      uint64
+	receipt   *types.Receipt
+	nonce     uint64
+	revert    error
+	callBlock *big.Int
+}
+
+func (c *stubReceiptClient) TransactionReceipt(context.Context, common.Hash) (*types.Receipt, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.receipt == nil {
+		return nil, ethereum.NotFound
+	}
+	return c.receipt, nil
+}
+
+func (c *stubReceiptClient) BlockNumber(context.Context) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.head++
+	return c.head, nil
+}
+
+func (c *stubReceiptClient) NonceAt(context.Context, common.Address, *big.Int) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.nonce, nil
+}
+
+func (c *stubReceiptClient) CallContract(_ context.Context, _ ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.callBlock = block
+	return nil, c.revert
+}
+
+func signedTestTx(t *testing.T) *types.Transaction {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := NewKeySigner(key).SignTx(context.Background(), newTestTx(), testChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func withFastPolling(t *testing.T) {
+	interval := receiptPollInterval
+	receiptPollInterval = time.Millisecond
+	t.Cleanup(func() { receiptPollInterval = interval })
+}
+
+func TestWaitMinedWaitsForConfirmations(t *testing.T) {
+	withFastPolling(t)
+	client := &stubReceiptClient{
+		head:    10,
+		receipt: &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(11)},
+	}
+
+	receipt, err := WaitMined(context.Background(), client, signedTestTx(t), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.BlockNumber.Uint64() != 11 {
+		t.Errorf("receipt block = %d", receipt.BlockNumber)
+	}
+	// 区块11、12、13 共3个确认
+	if client.head != 13 {
+		t.Errorf("returned at head %d, want 13", client.head)
+	}
+}
+
+func TestWaitMinedDecodesRevertReason(t *testing.T) {
+	withFastPolling(t)
+	client := &stubReceiptClient{
+		receipt: &types.Receipt{Status: types.ReceiptStatusFailed, BlockNumber: big.NewInt(20)},
+		revert:  rpcRevertError{data: encodeRevert(t, "cannot decrement below zero")},
+	}
+
+	receipt, err := WaitMined(context.Background(), client, signedTestTx(t), 1)
+	if receipt == nil || !errors.Is(err, ErrTxFailed) {
+		t.Fatalf("receipt = %v, err = %v; want receipt and ErrTxFailed", receipt, err)
+	}
+	revert, ok := AsRevert(err)
+	if !ok || revert.Reason != "cannot decrement below zero" {
+		t.Errorf("revert = %v, want reason from require", revert)
+	}
+	if client.callBlock.Uint64() != 19 {
+		t.Errorf("replayed at block %d, want parent block 19", client.callBlock)
+	}
+}
+
+func TestWaitMinedDetectsReplacement(t *testing.T) {
+	withFastPolling(t)
+	tx := signedTestTx(t)
+	client := &stubReceiptClient{nonce: tx.Nonce() + 1}
+
+	if _, err := WaitMined(context.Background(), client, tx, 1); !errors.Is(err, ErrReplaced) {
+		t.Errorf("err = %v, want ErrReplaced", err)
+	}
+}
+
+func TestWaitMinedHonorsContextTimeout(t *testing.T) {
+	withFastPolling(t)
+	tx := signedTestTx(t)
+	client := &stubReceiptClient{nonce: tx.Nonce()}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := WaitMined(ctx, client, tx, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want context.DeadlineExceeded", err)
+	}
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+
+	"ethtx"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// tokenCallGasLimit 节点无法估算时 ERC-20 transfer/approve 使用的gas限制
+const tokenCallGasLimit = 100000
+
+// erc20Usage erc20 命令的用法
+const erc20Usage = `用法: go run . [-json] erc20 [-token 地址] <操作> [参数]
+
+操作:
+  balance [账户]             查询代币余额，默认查询签名账户
+  allowance <所有者> <被授权者> 查询授权额度
+  transfer <接收地址> <数量>   转账，数量按代币精度解析，例如 1.5
+  approve <被授权者> <数量>    授权额度，数量为0时取消授权
+
+选项:
+`
+
+// tokenQuery balance 和 allowance 的查询结果
+type tokenQuery struct {
+	Token    string `json:"token"`
+	Symbol   string `json:"symbol,omitempty"`
+	Decimals uint8  `json:"decimals"`
+	Account  string `json:"account,omitempty"` // balance
+	Owner    string `json:"owner,omitempty"`   // allowance
+	Spender  string `json:"spender,omitempty"`
+	Amount   string `json:"amount"`     // 按精度格式化的数量
+	Raw      string `json:"amount_raw"` // 最小单位的整数
+}
+
+// erc20Command 处理 erc20 命令：查询余额和授权额度，发送 transfer 和 approve 交易
+func erc20Command(client *ethtx.Client, chainID *big.Int, args []string, jsonOutput bool, out io.Writer) error {
+	flags := flag.NewFlagSet("erc20", flag.ExitOnError)
+	tokenAddress := flags.String("token", "", "ERC-20代币合约地址，默认读取 TOKEN_ADDRESS")
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), erc20Usage)
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	args = flags.Args()
+	if len(args) == 0 {
+		flags.Usage()
+		return errors.New("missing erc20 operation")
+	}
+
+	if *tokenAddress == "" {
+		*tokenAddress = os.Getenv("TOKEN_ADDRESS")
+	}
+	address, err := parseAddress("token", *tokenAddress)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	token, err := ethtx.NewToken(ctx, client, address)
+	if err != nil {
+		return err
+	}
+
+	op, args := args[0], args[1:]
+	switch op {
+	case "balance":
+		if len(args) > 1 {
+			return errors.New("usage: erc20 balance [account]")
+		}
+		account, err := accountOrSigner(args)
+		if err != nil {
+			return err
+		}
+		balance, err := token.BalanceOf(ctx, account)
+		if err != nil {
+			return err
+		}
+		result := newTokenQuery(token, balance)
+		result.Account = account.Hex()
+		return printTokenQuery(out, jsonOutput, result, fmt.Sprintf("Balance of %s: %s", account.Hex(), token.FormatAmount(balance)))
+
+	case "allowance":
+		if len(args) != 2 {
+			return errors.New("usage: erc20 allowance <owner> <spender>")
+		}
+		owner, err := parseAddress("owner", args[0])
+		if err != nil {
+			return err
+		}
+		spender, err := parseAddress("spender", args[1])
+		if err != nil {
+			return err
+		}
+		allowance, err := token.Allowance(ctx, owner, spender)
+		if err != nil {
+			return err
+		}
+		result := newTokenQuery(token, allowance)
+		result.Owner, result.Spender = owner.Hex(), spender.Hex()
+		return printTokenQuery(out, jsonOutput, result, fmt.Sprintf("Allowance of %s for %s: %s", owner.Hex(), spender.Hex(), token.FormatAmount(allowance)))
+
+	case "transfer", "approve":
+		if len(args) != 2 {
+			return fmt.Errorf("usage: erc20 %s <address> <amount>", op)
+		}
+		return tokenTransact(client, chainID, token, op, args[0], args[1])
+
+	default:
+		flags.Usage()
+		return fmt.Errorf("unknown erc20 operation %q", op)
+	}
+}
+
+// tokenTransact 发送 transfer 或 approve 交易，上链后输出新的余额或授权额度
+func tokenTransact(client *ethtx.Client, chainID *big.Int, token *ethtx.Token, op, target, amountStr string) error {
+	to, err := parseAddress("address", target)
+	if err != nil {
+		return err
+	}
+	amount, err := token.ParseAmount(amountStr)
+	if err != nil {
+		return fmt.Errorf("amount: %w", err)
+	}
+	signer, err := signerFromEnv()
+	if err != nil {
+		return fmt.Errorf("failed to create signer: %w", err)
+	}
+	ctx := context.Background()
+
+	var (
+		data        []byte
+		description string
+	)
+	switch op {
+	case "transfer":
+		// OpenZeppelin 的余额不足是自定义错误，估算gas时无法解码为可读原因，这里提前检查
+		balance, err := token.BalanceOf(ctx, signer.Address())
+		if err != nil {
+			return err
+		}
+		if balance.Cmp(amount) < 0 {
+			return fmt.Errorf("insufficient token balance: have %s, want %s", token.FormatAmount(balance), token.FormatAmount(amount))
+		}
+		data, err = token.PackTransfer(to, amount)
+		if err != nil {
+			return err
+		}
+		description = fmt.Sprintf("Transfer: %s to %s", token.FormatAmount(amount), to.Hex())
+	case "approve":
+		data, err = token.PackApprove(to, amount)
+		if err != nil {
+			return err
+		}
+		description = fmt.Sprintf("Approve: %s for %s", token.FormatAmount(amount), to.Hex())
+	}
+
+	// 交易发往代币合约，不附带ETH
+	if _, err := transact(client, signer, chainID, token.Address, new(big.Int), data, tokenCallGasLimit, description); err != nil {
+		return err
+	}
+
+	// 带转账税的代币（例如 ShibMemeToken）接收方实际到账可能少于转账数量，以链上余额为准
+	switch op {
+	case "transfer":
+		balance, err := token.BalanceOf(ctx, signer.Address())
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Balance: %s\n", token.FormatAmount(balance))
+	case "approve":
+		allowance, err := token.Allowance(ctx, signer.Address(), to)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Allowance: %s\n", token.FormatAmount(allowance))
+	}
+	return nil
+}
+
+func newTokenQuery(token *ethtx.Token, amount *big.Int) tokenQuery {
+	return tokenQuery{
+		Token:    token.Address.Hex(),
+		Symbol:   token.Symbol,
+		Decimals: token.Decimals,
+		Amount:   ethtx.FormatUnits(amount, token.Decimals),
+		Raw:      amount.String(),
+	}
+}
+
+func printTokenQuery(out io.Writer, jsonOutput bool, result tokenQuery, text string) error {
+	if jsonOutput {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result)
+	}
+	_, err := fmt.Fprintln(out, text)
+	return err
+}
+
+// accountOrSigner 返回参数中的账户，没有参数时使用签名账户
+func accountOrSigner(args []string) (common.Address, error) {
+	if len(args) > 0 {
+		return parseAddress("account", args[0])
+	}
+	signer, err := signerFromEnv()
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to create signer: %w", err)
+	}
+	return signer.Address(), nil
+}
+
+// parseAddress 校验并解析十六进制地址
+func parseAddress(name, s string) (common.Address, error) {
+	if s == "" {
+		return common.Address{}, fmt.Errorf("%s address is required", name)
+	}
+	if !common.IsHexAddress(s) {
+		return common.Address{}, fmt.Errorf("invalid %s address %q", name, s)
+	}
+	return common.HexToAddress(s), nil
+}
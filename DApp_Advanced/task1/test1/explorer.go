@@ -0,0 +1,384 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"ethtx"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// explorerClient 查询区块和交易需要的节点接口，*ethclient.Client 实现了该接口
+type explorerClient interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+	BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// blockTags 支持的区块标签
+var blockTags = map[string]rpc.BlockNumber{
+	"latest":    rpc.LatestBlockNumber,
+	"pending":   rpc.PendingBlockNumber,
+	"safe":      rpc.SafeBlockNumber,
+	"finalized": rpc.FinalizedBlockNumber,
+	"earliest":  rpc.EarliestBlockNumber,
+}
+
+// blockRef 区块查询参数，hash 为空时按区块号查询
+type blockRef struct {
+	number *big.Int
+	hash   *common.Hash
+}
+
+// parseBlockRef 解析区块号（十进制或0x开头的十六进制）、32字节区块哈希或 latest 等标签，空字符串表示 latest
+func parseBlockRef(s string) (blockRef, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		s = "latest"
+	}
+	if tag, ok := blockTags[strings.ToLower(s)]; ok {
+		return blockRef{number: big.NewInt(tag.Int64())}, nil
+	}
+	if has0xPrefix(s) && len(s) == 2+2*common.HashLength {
+		hash := common.HexToHash(s)
+		if hash.Hex() != strings.ToLower(s) {
+			return blockRef{}, fmt.Errorf("invalid block hash %q", s)
+		}
+		return blockRef{hash: &hash}, nil
+	}
+
+	base, digits := 10, s
+	if has0xPrefix(s) {
+		base, digits = 16, s[2:]
+	}
+	n, err := strconv.ParseUint(digits, base, 64)
+	if err != nil {
+		return blockRef{}, fmt.Errorf("invalid block %q: want a number, hash or one of latest, pending, safe, finalized, earliest", s)
+	}
+	return blockRef{number: new(big.Int).SetUint64(n)}, nil
+}
+
+func has0xPrefix(s string) bool {
+	return len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X')
+}
+
+// fetchBlock 按区块号、哈希或标签查询区块
+func fetchBlock(ctx context.Context, client explorerClient, ref blockRef) (*types.Block, error) {
+	if ref.hash != nil {
+		return client.BlockByHash(ctx, *ref.hash)
+	}
+	return client.BlockByNumber(ctx, ref.number)
+}
+
+// blockInfo 区块查询结果
+type blockInfo struct {
+	Number       uint64   `json:"number"`
+	Hash         string   `json:"hash"`
+	ParentHash   string   `json:"parent_hash"`
+	Timestamp    uint64   `json:"timestamp"`
+	Time         string   `json:"time"`
+	Miner        string   `json:"miner"`
+	GasLimit     uint64   `json:"gas_limit"`
+	GasUsed      uint64   `json:"gas_used"`
+	BaseFeeGwei  string   `json:"base_fee_gwei,omitempty"` // 伦敦升级之前的区块没有基础费用
+	TxCount      int      `json:"tx_count"`
+	Transactions []txInfo `json:"transactions"`
+}
+
+// txInfo 交易查询结果，From 通过签名恢复
+type txInfo struct {
+	Hash         string       `json:"hash"`
+	Type         string       `json:"type"`
+	From         string       `json:"from"`
+	To           string       `json:"to,omitempty"` // 合约创建交易为空
+	ValueEth     string       `json:"value_eth"`
+	Nonce        uint64       `json:"nonce"`
+	Gas          uint64       `json:"gas"`
+	GasPriceGwei string       `json:"gas_price_gwei,omitempty"` // legacy 和 access_list 交易
+	TipCapGwei   string       `json:"tip_cap_gwei,omitempty"`   // EIP-1559 及之后的交易类型
+	FeeCapGwei   string       `json:"fee_cap_gwei,omitempty"`
+	DataSize     int          `json:"data_size"`
+	Pending      bool         `json:"pending,omitempty"`
+	Receipt      *receiptInfo `json:"receipt,omitempty"`
+}
+
+// receiptInfo 交易回执
+type receiptInfo struct {
+	Status                string `json:"status"` // success 或 failed
+	BlockNumber           uint64 `json:"block_number"`
+	BlockHash             string `json:"block_hash"`
+	TxIndex               uint   `json:"tx_index"`
+	GasUsed               uint64 `json:"gas_used"`
+	EffectiveGasPriceGwei string `json:"effective_gas_price_gwei,omitempty"`
+	ContractAddress       string `json:"contract_address,omitempty"`
+	Logs                  int    `json:"logs"`
+}
+
+// txTypeName 返回交易类型的名称
+func txTypeName(txType uint8) string {
+	switch txType {
+	case types.LegacyTxType:
+		return "legacy"
+	case types.AccessListTxType:
+		return "access_list"
+	case types.DynamicFeeTxType:
+		return "dynamic_fee"
+	case types.BlobTxType:
+		return "blob"
+	case types.SetCodeTxType:
+		return "set_code"
+	default:
+		return fmt.Sprintf("0x%x", txType)
+	}
+}
+
+// newTxInfo 解码交易的发送方、金额、类型和gas，发送方无法恢复时为空
+func newTxInfo(tx *types.Transaction, signer types.Signer) txInfo {
+	info := txInfo{
+		Hash:     tx.Hash().Hex(),
+		Type:     txTypeName(tx.Type()),
+		ValueEth: ethtx.FormatEther(tx.Value()),
+		Nonce:    tx.Nonce(),
+		Gas:      tx.Gas(),
+		DataSize: len(tx.Data()),
+	}
+	if from, err := types.Sender(signer, tx); err == nil {
+		info.From = from.Hex()
+	}
+	if to := tx.To(); to != nil {
+		info.To = to.Hex()
+	}
+	switch tx.Type() {
+	case types.LegacyTxType, types.AccessListTxType:
+		info.GasPriceGwei = ethtx.FormatGwei(tx.GasPrice())
+	default:
+		info.TipCapGwei = ethtx.FormatGwei(tx.GasTipCap())
+		info.FeeCapGwei = ethtx.FormatGwei(tx.GasFeeCap())
+	}
+	return info
+}
+
+// newBlockInfo 汇总区块头和区块中的交易
+func newBlockInfo(block *types.Block, signer types.Signer) *blockInfo {
+	info := &blockInfo{
+		Number:       block.NumberU64(),
+		Hash:         block.Hash().Hex(),
+		ParentHash:   block.ParentHash().Hex(),
+		Timestamp:    block.Time(),
+		Time:         time.Unix(int64(block.Time()), 0).UTC().Format(time.RFC3339),
+		Miner:        block.Coinbase().Hex(),
+		GasLimit:     block.GasLimit(),
+		GasUsed:      block.GasUsed(),
+		TxCount:      len(block.Transactions()),
+		Transactions: make([]txInfo, 0, len(block.Transactions())),
+	}
+	if baseFee := block.BaseFee(); baseFee != nil {
+		info.BaseFeeGwei = ethtx.FormatGwei(baseFee)
+	}
+	for _, tx := range block.Transactions() {
+		info.Transactions = append(info.Transactions, newTxInfo(tx, signer))
+	}
+	return info
+}
+
+// newReceiptInfo 汇总交易回执
+func newReceiptInfo(receipt *types.Receipt) *receiptInfo {
+	info := &receiptInfo{
+		Status:      "success",
+		BlockNumber: receipt.BlockNumber.Uint64(),
+		BlockHash:   receipt.BlockHash.Hex(),
+		TxIndex:     receipt.TransactionIndex,
+		GasUsed:     receipt.GasUsed,
+		Logs:        len(receipt.Logs),
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		info.Status = "failed"
+	}
+	if receipt.EffectiveGasPrice != nil {
+		info.EffectiveGasPriceGwei = ethtx.FormatGwei(receipt.EffectiveGasPrice)
+	}
+	if receipt.ContractAddress != (common.Address{}) {
+		info.ContractAddress = receipt.ContractAddress.Hex()
+	}
+	return info
+}
+
+// explorer 区块和交易查询，结果输出为表格或JSON
+type explorer struct {
+	client explorerClient
+	json   bool
+	out    io.Writer
+}
+
+// signer 返回按当前链ID恢复交易发送方的签名器，兼容所有交易类型
+func (e *explorer) signer(ctx context.Context) (types.Signer, error) {
+	chainID, err := e.client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get chain ID: %w", err)
+	}
+	return types.LatestSignerForChainID(chainID), nil
+}
+
+// block 查询区块及其中的交易
+func (e *explorer) block(ctx context.Context, ref string) error {
+	parsed, err := parseBlockRef(ref)
+	if err != nil {
+		return err
+	}
+	signer, err := e.signer(ctx)
+	if err != nil {
+		return err
+	}
+	block, err := fetchBlock(ctx, e.client, parsed)
+	if err != nil {
+		return fmt.Errorf("get block %s: %w", ref, err)
+	}
+
+	info := newBlockInfo(block, signer)
+	if e.json {
+		return e.writeJSON(info)
+	}
+	e.printBlock(info)
+	return nil
+}
+
+// transaction 查询交易及其回执，交易仍在交易池中时没有回执
+func (e *explorer) transaction(ctx context.Context, hashHex string) error {
+	if !has0xPrefix(hashHex) || len(hashHex) != 2+2*common.HashLength {
+		return fmt.Errorf("invalid transaction hash %q", hashHex)
+	}
+	hash := common.HexToHash(hashHex)
+
+	signer, err := e.signer(ctx)
+	if err != nil {
+		return err
+	}
+	tx, pending, err := e.client.TransactionByHash(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return fmt.Errorf("transaction %s not found", hash.Hex())
+	}
+	if err != nil {
+		return fmt.Errorf("get transaction %s: %w", hash.Hex(), err)
+	}
+
+	info := newTxInfo(tx, signer)
+	info.Pending = pending
+	if !pending {
+		receipt, err := e.client.TransactionReceipt(ctx, hash)
+		if err != nil && !errors.Is(err, ethereum.NotFound) {
+			return fmt.Errorf("get receipt of %s: %w", hash.Hex(), err)
+		}
+		if receipt != nil {
+			info.Receipt = newReceiptInfo(receipt)
+		}
+	}
+
+	if e.json {
+		return e.writeJSON(info)
+	}
+	e.printTransaction(&info)
+	return nil
+}
+
+func (e *explorer) writeJSON(v any) error {
+	encoder := json.NewEncoder(e.out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func (e *explorer) printBlock(info *blockInfo) {
+	fmt.Fprintf(e.out, "\n=== Block %d ===\n", info.Number)
+	w := tabwriter.NewWriter(e.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Hash:\t%s\n", info.Hash)
+	fmt.Fprintf(w, "Parent Hash:\t%s\n", info.ParentHash)
+	fmt.Fprintf(w, "Time:\t%s (%d)\n", info.Time, info.Timestamp)
+	fmt.Fprintf(w, "Miner:\t%s\n", info.Miner)
+	fmt.Fprintf(w, "Gas Used:\t%d / %d\n", info.GasUsed, info.GasLimit)
+	if info.BaseFeeGwei != "" {
+		fmt.Fprintf(w, "Base Fee:\t%s gwei\n", info.BaseFeeGwei)
+	}
+	fmt.Fprintf(w, "Transactions:\t%d\n", info.TxCount)
+	w.Flush()
+
+	if len(info.Transactions) == 0 {
+		return
+	}
+	fmt.Fprintln(e.out)
+	w = tabwriter.NewWriter(e.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "HASH\tTYPE\tFROM\tTO\tVALUE (ETH)\tGAS")
+	for _, tx := range info.Transactions {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\n", tx.Hash, tx.Type, orDash(tx.From), toOrCreate(tx.To), tx.ValueEth, tx.Gas)
+	}
+	w.Flush()
+}
+
+func (e *explorer) printTransaction(info *txInfo) {
+	fmt.Fprintln(e.out, "\n=== Transaction ===")
+	w := tabwriter.NewWriter(e.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Hash:\t%s\n", info.Hash)
+	fmt.Fprintf(w, "Type:\t%s\n", info.Type)
+	fmt.Fprintf(w, "From:\t%s\n", orDash(info.From))
+	fmt.Fprintf(w, "To:\t%s\n", toOrCreate(info.To))
+	fmt.Fprintf(w, "Value:\t%s ETH\n", info.ValueEth)
+	fmt.Fprintf(w, "Nonce:\t%d\n", info.Nonce)
+	fmt.Fprintf(w, "Gas Limit:\t%d\n", info.Gas)
+	if info.GasPriceGwei != "" {
+		fmt.Fprintf(w, "Gas Price:\t%s gwei\n", info.GasPriceGwei)
+	} else {
+		fmt.Fprintf(w, "Max Priority Fee:\t%s gwei\n", info.TipCapGwei)
+		fmt.Fprintf(w, "Max Fee:\t%s gwei\n", info.FeeCapGwei)
+	}
+	fmt.Fprintf(w, "Data:\t%d bytes\n", info.DataSize)
+	if info.Pending {
+		fmt.Fprintf(w, "Status:\tpending\n")
+	}
+	w.Flush()
+
+	receipt := info.Receipt
+	if receipt == nil {
+		return
+	}
+	fmt.Fprintln(e.out, "\n=== Receipt ===")
+	w = tabwriter.NewWriter(e.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Status:\t%s\n", receipt.Status)
+	fmt.Fprintf(w, "Block:\t%d (%s)\n", receipt.BlockNumber, receipt.BlockHash)
+	fmt.Fprintf(w, "Index:\t%d\n", receipt.TxIndex)
+	fmt.Fprintf(w, "Gas Used:\t%d\n", receipt.GasUsed)
+	if receipt.EffectiveGasPriceGwei != "" {
+		fmt.Fprintf(w, "Effective Gas Price:\t%s gwei\n", receipt.EffectiveGasPriceGwei)
+	}
+	if receipt.ContractAddress != "" {
+		fmt.Fprintf(w, "Contract Address:\t%s\n", receipt.ContractAddress)
+	}
+	fmt.Fprintf(w, "Logs:\t%d\n", receipt.Logs)
+	w.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// toOrCreate 合约创建交易没有接收方
+func toOrCreate(to string) string {
+	if to == "" {
+		return "(contract creation)"
+	}
+	return to
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var testChainID = big.NewInt(11155111)
+
+func TestParseBlockRef(t *testing.T) {
+	hash := "0x" + strings.Repeat("ab", 32)
+	tests := []struct {
+		in         string
+		wantNumber int64
+		wantHash   bool
+		wantErr    bool
+	}{
+		{"", rpc.LatestBlockNumber.Int64(), false, false},
+		{"latest", rpc.LatestBlockNumber.Int64(), false, false},
+		{"Finalized", rpc.FinalizedBlockNumber.Int64(), false, false},
+		{"pending", rpc.PendingBlockNumber.Int64(), false, false},
+		{"5500000", 5500000, false, false},
+		{"0x10", 16, false, false},
+		{hash, 0, true, false},
+		{"0x" + strings.Repeat("zz", 32), 0, false, true},
+		{"-1", 0, false, true},
+		{"newest", 0, false, true},
+	}
+	for _, tt := range tests {
+		ref, err := parseBlockRef(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseBlockRef(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		switch {
+		case err != nil:
+		case tt.wantHash:
+			if ref.hash == nil || ref.hash.Hex() != hash {
+				t.Errorf("parseBlockRef(%q) hash = %v", tt.in, ref.hash)
+			}
+		case ref.hash != nil || ref.number.Int64() != tt.wantNumber:
+			t.Errorf("parseBlockRef(%q) = %+v, want number %d", tt.in, ref, tt.wantNumber)
+		}
+	}
+}
+
+// stubExplorerClient 保存一个区块和其中交易的回执
+type stubExplorerClient struct {
+	block    *types.Block
+	receipts map[common.Hash]*types.Receipt
+	pending  *types.Transaction
+}
+
+func (c *stubExplorerClient) ChainID(context.Context) (*big.Int, error) {
+	return testChainID, nil
+}
+
+func (c *stubExplorerClient) BlockByNumber(_ context.Context, number *big.Int) (*types.Block, error) {
+	if number.Sign() < 0 || number.Cmp(c.block.Number()) == 0 {
+		return c.block, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+func (c *stubExplorerClient) BlockByHash(_ context.Context, hash common.Hash) (*types.Block, error) {
+	if hash == c.block.Hash() {
+		return c.block, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+func (c *stubExplorerClient) TransactionByHash(_ context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	if c.pending != nil && hash == c.pending.Hash() {
+		return c.pending, true, nil
+	}
+	for _, tx := range c.block.Transactions() {
+		if tx.Hash() == hash {
+			return tx, false, nil
+		}
+	}
+	return nil, false, ethereum.NotFound
+}
+
+func (c *stubExplorerClient) TransactionReceipt(_ context.Context, hash common.Hash) (*types.Receipt, error) {
+	if receipt, ok := c.receipts[hash]; ok {
+		return receipt, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+// newStubExplorer 创建包含一笔 EIP-1559 转账和一笔 legacy 合约创建交易的区块
+func newStubExplorer(t *testing.T) (*stubExplorerClient, common.Address) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer := types.LatestSignerForChainID(testChainID)
+	to := common.HexToAddress("0x1f98C5751Ba74946B05e2cD73C5B0174dF2195d0")
+
+	transfer := types.MustSignNewTx(key, signer, &types.DynamicFeeTx{
+		ChainID:   testChainID,
+		Nonce:     7,
+		GasTipCap: big.NewInt(params.GWei),
+		GasFeeCap: big.NewInt(30 * params.GWei),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(1e16),
+	})
+	create := types.MustSignNewTx(key, signer, &types.LegacyTx{
+		Nonce:    8,
+		GasPrice: big.NewInt(2 * params.GWei),
+		Gas:      100000,
+		Data:     []byte{0x60, 0x80},
+	})
+
+	header := &types.Header{
+		Number:   big.NewInt(5500000),
+		Time:     1700000000,
+		GasLimit: 30000000,
+		GasUsed:  121000,
+		BaseFee:  big.NewInt(params.GWei / 2),
+	}
+	block := types.NewBlockWithHeader(header).WithBody(types.Body{Transactions: types.Transactions{transfer, create}})
+
+	client := &stubExplorerClient{block: block, receipts: map[common.Hash]*types.Receipt{
+		transfer.Hash(): {
+			Status:            types.ReceiptStatusSuccessful,
+			BlockNumber:       block.Number(),
+			BlockHash:         block.Hash(),
+			GasUsed:           21000,
+			EffectiveGasPrice: big.NewInt(params.GWei * 3 / 2),
+		},
+	}}
+	return client, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func TestExplorerBlockJSON(t *testing.T) {
+	client, from := newStubExplorer(t)
+	out := &bytes.Buffer{}
+	e := &explorer{client: client, json: true, out: out}
+
+	if err := e.block(context.Background(), client.block.Hash().Hex()); err != nil {
+		t.Fatal(err)
+	}
+	var info blockInfo
+	if err := json.Unmarshal(out.Bytes(), &info); err != nil {
+		t.Fatal(err)
+	}
+	if info.Number != 5500000 || info.TxCount != 2 || info.BaseFeeGwei != "0.5" || info.Time != "2023-11-14T22:13:20Z" {
+		t.Errorf("block = %+v", info)
+	}
+
+	transfer, create := info.Transactions[0], info.Transactions[1]
+	if transfer.From != from.Hex() || transfer.Type != "dynamic_fee" || transfer.ValueEth != "0.01" ||
+		transfer.TipCapGwei != "1" || transfer.FeeCapGwei != "30" || transfer.Gas != 21000 {
+		t.Errorf("transfer = %+v", transfer)
+	}
+	if create.From != from.Hex() || create.Type != "legacy" || create.To != "" || create.GasPriceGwei != "2" || create.DataSize != 2 {
+		t.Errorf("contract creation = %+v", create)
+	}
+}
+
+func TestExplorerBlockTable(t *testing.T) {
+	client, from := newStubExplorer(t)
+	out := &bytes.Buffer{}
+	e := &explorer{client: client, out: out}
+
+	if err := e.block(context.Background(), "latest"); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"=== Block 5500000 ===", "Base Fee:", "0.5 gwei", from.Hex(), "(contract creation)", "dynamic_fee"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+
+	if err := e.block(context.Background(), "123"); err == nil {
+		t.Error("missing block: want error")
+	}
+}
+
+func TestExplorerTransaction(t *testing.T) {
+	client, from := newStubExplorer(t)
+	transfer := client.block.Transactions()[0]
+	out := &bytes.Buffer{}
+	e := &explorer{client: client, json: true, out: out}
+
+	if err := e.transaction(context.Background(), transfer.Hash().Hex()); err != nil {
+		t.Fatal(err)
+	}
+	var info txInfo
+	if err := json.Unmarshal(out.Bytes(), &info); err != nil {
+		t.Fatal(err)
+	}
+	if info.From != from.Hex() || info.Nonce != 7 || info.Receipt == nil ||
+		info.Receipt.Status != "success" || info.Receipt.EffectiveGasPriceGwei != "1.5" || info.Receipt.BlockNumber != 5500000 {
+		t.Errorf("transaction = %+v, receipt = %+v", info, info.Receipt)
+	}
+
+	// 交易池中的交易没有回执
+	client.pending = client.block.Transactions()[1]
+	out.Reset()
+	e.json = false
+	if err := e.transaction(context.Background(), client.pending.Hash().Hex()); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "pending") || strings.Contains(out.String(), "=== Receipt ===") {
+		t.Errorf("pending transaction output:\n%s", out.String())
+	}
+
+	if err := e.transaction(context.Background(), "0x1234"); err == nil {
+		t.Error("invalid hash: want error")
+	}
+	if err := e.transaction(context.Background(), common.Hash{1}.Hex()); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("unknown transaction: err = %v", err)
+	}
+}
@@ -2,88 +2,231 @@ package main
 
 import (
 	"context"
-	"crypto/ecdsa"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"math/big"
 
 	"os"
+	"strconv"
+	"time"
 
+	"ethtx"
+
+	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/joho/godotenv"
 )
 
+// usage 命令行用法
+const usage = `用法: go run . [-network 网络] [-json] <命令> [参数]
+
+命令:
+  send              从配置的账户向 RECIPIENT_ADDRESS 发送0.01 ETH（默认）
+  block [区块]      查询区块及其中的交易，区块为区块号、区块哈希或 latest/pending/safe/finalized/earliest，默认 latest
+  tx <交易哈希>     查询交易及其回执
+  erc20 <操作>      查询ERC-20余额和授权额度，发送 transfer 和 approve 交易，详见 erc20 -h
+  payout <CSV>      按CSV批量发送ETH，可中断后重新运行，详见 payout -h
+
+选项:
+`
+
 func main() {
+	jsonOutput := flag.Bool("json", false, "以JSON格式输出查询结果")
+	networkName := flag.String("network", "", "网络："+networkNames()+"，默认读取 NETWORK，未设置时为 "+defaultNetwork)
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	command, args := "send", flag.Args()
+	if len(args) > 0 {
+		command, args = args[0], args[1:]
+	}
+	switch command {
+	case "send", "block", "tx", "erc20", "payout":
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
 	// 加载环境变量
 	err := godotenv.Load()
 	if err != nil {
 		log.Fatalf("Error loading .env file: %v", err)
 	}
 
-	// 连接到Sepolia测试网络
-	infuraAPIKey := os.Getenv("INFURA_API_KEY")
-	if infuraAPIKey == "" {
-		log.Fatal("INFURA_API_KEY is not set in .env file")
+	// 按网络配置连接节点，命令行参数优先于 NETWORK 环境变量
+	if *networkName == "" {
+		*networkName = os.Getenv("NETWORK")
+	}
+	net, err := resolveNetwork(*networkName, os.Getenv)
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	client, err := ethclient.Dial(fmt.Sprintf("https://sepolia.infura.io/v3/%s", infuraAPIKey))
+	// 多个节点时查询请求在限流、5xx和超时时重试并切换节点，发送交易不会重复广播
+	clientConfig := ethtx.DefaultClientConfig(net.URLs...)
+	clientConfig.ChainID = net.ChainID
+	client, err := ethtx.DialClient(context.Background(), clientConfig)
 	if err != nil {
 		log.Fatalf("Failed to connect to the Ethereum client: %v", err)
 	}
+	defer client.Close()
 
-	fmt.Println("Successfully connected to Sepolia testnet")
+	// 在任何签名之前确认节点所在的链，之后所有交易都使用这里确认的链ID
+	if err := net.verifyChainID(context.Background(), client); err != nil {
+		log.Fatal(err)
+	}
 
-	// 查询区块示例
-	// blockNumber := big.NewInt(5500000) // 替换为你想要查询的区块号
-	// queryBlock(client, blockNumber)
+	// JSON输出时标准输出只包含查询结果
+	if !*jsonOutput {
+		fmt.Printf("Successfully connected to %s (chain ID %s) via %s\n", net.Name, net.ChainID, net.redactedURL())
+	}
 
-	// 发送交易示例
-	sendTransaction(client)
+	explorer := &explorer{client: client, json: *jsonOutput, out: os.Stdout}
+	switch command {
+	case "block":
+		ref := ""
+		if len(args) > 0 {
+			ref = args[0]
+		}
+		err = explorer.block(context.Background(), ref)
+	case "tx":
+		if len(args) != 1 {
+			log.Fatal("usage: tx <transaction hash>")
+		}
+		err = explorer.transaction(context.Background(), args[0])
+	case "erc20":
+		err = erc20Command(client, net.ChainID, args, *jsonOutput, os.Stdout)
+	case "payout":
+		err = payoutCommand(client, net.ChainID, args, os.Stdout)
+	default:
+		sendTransaction(client, net.ChainID)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
 }
 
-// 查询区块信息
-func queryBlock(client *ethclient.Client, blockNumber *big.Int) {
-	block, err := client.BlockByNumber(context.Background(), blockNumber)
-	if err != nil {
-		log.Fatalf("Failed to get block: %v", err)
-	}
-
-	fmt.Println("\n=== Block Information ===")
-	fmt.Printf("Block Number: %d\n", block.Number().Uint64())
-	fmt.Printf("Block Hash Hex: %s\n", block.Hash().Hex())
-	fmt.Printf("Block Hash: %s\n", block.Hash())
-	fmt.Printf("Parent Hash: %s\n", block.ParentHash().Hex())
-	fmt.Printf("Time Stamp: %d\n", block.Time())
-	fmt.Printf("Difficulty: %d\n", block.Difficulty().Uint64())
-	fmt.Printf("Gas Limit: %d\n", block.GasLimit())
-	fmt.Printf("Gas Used: %d\n", block.GasUsed())
-	fmt.Printf("Transaction Count: %d\n\n", len(block.Transactions()))
+// signerFromEnv 根据环境变量创建交易签名器
+// SIGNER_TYPE 为 env（默认，读取 PRIVATE_KEY）、keystore、mnemonic 或 external
+func signerFromEnv() (ethtx.Signer, error) {
+	cfg := ethtx.DefaultSignerConfig()
+	if signerType := os.Getenv("SIGNER_TYPE"); signerType != "" {
+		cfg.Type = signerType
+	}
+	cfg.KeystorePath = os.Getenv("KEYSTORE_PATH")
+	cfg.MnemonicEnv = "MNEMONIC"
+	cfg.DerivationPath = os.Getenv("DERIVATION_PATH")
+	cfg.ExternalURL = os.Getenv("EXTERNAL_SIGNER_URL")
+	cfg.Address = os.Getenv("EXTERNAL_SIGNER_ADDRESS")
+
+	// keystore 密码和助记词密码分别保存在不同的环境变量中
+	switch cfg.Type {
+	case ethtx.SignerTypeKeystore:
+		cfg.PassphraseEnv = "KEYSTORE_PASSPHRASE"
+	case ethtx.SignerTypeMnemonic:
+		cfg.PassphraseEnv = "MNEMONIC_PASSPHRASE"
+	}
+	return ethtx.NewSigner(cfg)
 }
 
-// 发送交易
-func sendTransaction(client *ethclient.Client) {
-	// 获取私钥
-	privateKeyStr := os.Getenv("PRIVATE_KEY")
-	if privateKeyStr == "" {
-		log.Fatal("PRIVATE_KEY is not set in .env file")
+// feeConfigFromEnv 根据环境变量创建手续费配置
+// FEE_STRATEGY 为 slow、normal（默认）、fast 或 fixed，fixed 时使用 TIP_CAP_GWEI 和 FEE_CAP_GWEI
+func feeConfigFromEnv() (ethtx.FeeConfig, error) {
+	cfg := ethtx.DefaultFeeConfig()
+	if strategy := os.Getenv("FEE_STRATEGY"); strategy != "" {
+		cfg.Strategy = strategy
 	}
+	cfg.TipCapGwei = os.Getenv("TIP_CAP_GWEI")
+	cfg.FeeCapGwei = os.Getenv("FEE_CAP_GWEI")
+	return cfg, cfg.Validate()
+}
 
-	privateKey, err := crypto.HexToECDSA(privateKeyStr)
+// gasConfigFromEnv 根据环境变量创建gas估算配置
+// GAS_MULTIPLIER 为估算值的安全系数（默认1.2），GAS_MAX 为上限，GAS_FALLBACK_LIMIT 为节点无法估算时的回退值（默认 fallback）
+func gasConfigFromEnv(fallback uint64) (ethtx.GasConfig, error) {
+	cfg := ethtx.DefaultGasConfig()
+	cfg.FallbackLimit = fallback
+	if value := os.Getenv("GAS_MULTIPLIER"); value != "" {
+		multiplier, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("GAS_MULTIPLIER: %q is not a number", value)
+		}
+		cfg.Multiplier = multiplier
+	}
+	for name, field := range map[string]*uint64{"GAS_MAX": &cfg.Max, "GAS_FALLBACK_LIMIT": &cfg.FallbackLimit} {
+		if value := os.Getenv(name); value != "" {
+			n, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return cfg, fmt.Errorf("%s: %q is not a non-negative integer", name, value)
+			}
+			*field = n
+		}
+	}
+	return cfg, cfg.Validate()
+}
+
+// minedTimeout 等待交易上链的超时时间，超时后加速交易
+const minedTimeout = 5 * time.Minute
+
+// waitMined 等待交易上链，最多等待 minedTimeout
+func waitMined(client ethtx.ReceiptClient, tx *types.Transaction) (*types.Receipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), minedTimeout)
+	defer cancel()
+	return ethtx.WaitMined(ctx, client, tx, 1)
+}
+
+// speedUpClient 加速交易所需的节点接口，*ethtx.Client 实现了该接口
+type speedUpClient interface {
+	ethtx.ReceiptClient
+	ethtx.FeeSource
+}
+
+// speedUp 用相同的nonce和更高的手续费重新发送卡住的交易 tx，返回实际上链的交易及其回执
+// 原交易和替换交易使用同一个nonce，只有一笔会上链：原交易在加速前后上链时，
+// 替换交易无法发送（ErrNonceUsed）或被报告为已替换（ErrReplaced），此时等待原交易的回执
+func speedUp(client speedUpClient, sender *ethtx.Sender, feeConfig ethtx.FeeConfig, tx *types.Transaction) (*types.Transaction, *types.Receipt, error) {
+	fees, err := ethtx.SuggestFees(context.Background(), client, feeConfig)
 	if err != nil {
-		log.Fatalf("Failed to parse private key: %v", err)
+		return nil, nil, fmt.Errorf("failed to suggest fees: %w", err)
+	}
+	replacement, err := sender.SpeedUp(context.Background(), tx, fees)
+	switch {
+	case errors.Is(err, ethtx.ErrNonceUsed):
+		log.Printf("Nonce %d was used before the replacement was sent, checking the original transaction", tx.Nonce())
+		receipt, err := waitMined(client, tx)
+		return tx, receipt, err
+	case errors.Is(err, ethtx.ErrSendUncertain):
+		log.Printf("Replacement transaction may not have reached the network: %v", err)
+	case err != nil:
+		return nil, nil, fmt.Errorf("failed to speed up transaction: %w", err)
 	}
+	fmt.Printf("Replacement Transaction Hash: %s\n", replacement.Hash().Hex())
 
-	// 从私钥获取公钥和地址
-	publicKey := privateKey.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		log.Fatal("Cannot assert type: publicKey is not of type *ecdsa.PublicKey")
+	receipt, err := waitMined(client, replacement)
+	if !errors.Is(err, ethtx.ErrReplaced) {
+		return replacement, receipt, err
+	}
+	log.Printf("Replacement transaction %s was not mined, checking the original transaction", replacement.Hash().Hex())
+	receipt, err = waitMined(client, tx)
+	return tx, receipt, err
+}
+
+// transferGasLimit 向普通账户转账固定消耗的gas
+const transferGasLimit = 21000
+
+// sendTransaction 从配置的账户发送0.01 ETH到 RECIPIENT_ADDRESS
+func sendTransaction(client *ethtx.Client, chainID *big.Int) {
+	// 创建交易签名器
+	signer, err := signerFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to create signer: %v", err)
 	}
-	// 获取发送方公共地址
-	fromAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
 
 	// 获取接收地址
 	toAddressStr := os.Getenv("RECIPIENT_ADDRESS")
@@ -93,46 +236,96 @@ func sendTransaction(client *ethclient.Client) {
 
 	toAddress := common.HexToAddress(toAddressStr)
 
-	// 获取发送方的nonce
-	nonce, err := client.PendingNonceAt(context.Background(), fromAddress)
-	if err != nil {
-		log.Fatalf("Failed to get nonce: %v", err)
-	}
-
 	// 设置转账金额 (0.01 ETH)
 	value := big.NewInt(10000000000000000) // 10^16 wei = 0.01 ETH
 
-	// 设置Gas价格和Gas限制
-	gasPrice, err := client.SuggestGasPrice(context.Background())
+	// 接收方是合约时可能超过21000，节点无法估算时按普通转账处理
+	_, err = transact(client, signer, chainID, toAddress, value, nil, transferGasLimit,
+		fmt.Sprintf("Amount: %s ETH", ethtx.FormatEther(value)))
 	if err != nil {
-		log.Fatalf("Failed to suggest gas price: %v", err)
+		log.Fatal(err)
 	}
+}
 
-	gasLimit := uint64(21000) // 简单转账交易的默认Gas限制
-
-	// 创建交易
-	var data []byte
-	tx := types.NewTransaction(nonce, toAddress, value, gasLimit, gasPrice, data)
+// transact 按手续费策略和gas估算发送一笔 EIP-1559 交易并等待上链，超时后加速一次
+// chainID 为连接时已与节点核对的链ID，fallbackGas 为节点无法估算gas时使用的gas限制（可被 GAS_FALLBACK_LIMIT 覆盖），description 描述交易内容
+func transact(client *ethtx.Client, signer ethtx.Signer, chainID *big.Int, toAddress common.Address, value *big.Int, data []byte, fallbackGas uint64, description string) (*types.Receipt, error) {
+	// 获取发送方公共地址
+	fromAddress := signer.Address()
 
-	// 签名交易
-	chainID, err := client.NetworkID(context.Background())
+	// 按手续费策略计算小费和费用上限
+	feeConfig, err := feeConfigFromEnv()
 	if err != nil {
-		log.Fatalf("Failed to get chain ID: %v", err)
+		return nil, fmt.Errorf("invalid fee config: %w", err)
+	}
+	fees, err := ethtx.SuggestFees(context.Background(), client, feeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest fees: %w", err)
 	}
 
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), privateKey)
+	// 估算Gas限制，交易会被回滚时返回回滚原因
+	gasConfig, err := gasConfigFromEnv(fallbackGas)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gas config: %w", err)
+	}
+	gasLimit, err := ethtx.EstimateGasLimit(context.Background(), client, gasConfig, ethereum.CallMsg{
+		From:      fromAddress,
+		To:        &toAddress,
+		GasTipCap: fees.TipCap,
+		GasFeeCap: fees.FeeCap,
+		Value:     value,
+		Data:      data,
+	})
 	if err != nil {
-		log.Fatalf("Failed to sign transaction: %v", err)
+		return nil, fmt.Errorf("failed to estimate gas: %w", err)
 	}
 
-	// 发送交易
-	if err := client.SendTransaction(context.Background(), signedTx); err != nil {
-		log.Fatalf("Failed to send transaction: %v", err)
+	// 创建 EIP-1559 交易，由发送器分配nonce、签名（LatestSignerForChainID）并发送
+	// 节点报告nonce过低时发送器会重新同步nonce并重建交易
+	sender := ethtx.NewSender(client, signer, chainID)
+	signedTx, err := sender.SendTx(context.Background(), func(nonce uint64) (*types.Transaction, error) {
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     nonce,
+			GasTipCap: fees.TipCap,
+			GasFeeCap: fees.FeeCap,
+			Gas:       gasLimit,
+			To:        &toAddress,
+			Value:     value,
+			Data:      data,
+		}), nil
+	})
+	switch {
+	case errors.Is(err, ethtx.ErrSendUncertain):
+		// 交易可能已经广播：不重新签名，继续等待这笔交易，超时后的加速会重新广播相同nonce的交易
+		log.Printf("Transaction %s may not have reached the network, waiting for it: %v", signedTx.Hash().Hex(), err)
+	case err != nil:
+		return nil, fmt.Errorf("failed to send transaction: %w", err)
 	}
 
 	fmt.Println("\n=== Transaction Sent ===")
 	fmt.Printf("Transaction Hash: %s\n", signedTx.Hash().Hex())
 	fmt.Printf("From: %s\n", fromAddress.Hex())
 	fmt.Printf("To: %s\n", toAddress.Hex())
-	fmt.Printf("Amount: 0.01 ETH\n")
+	fmt.Println(description)
+	fmt.Printf("Fees (%s): %s\n", feeConfig.Strategy, fees)
+	fmt.Printf("Gas Limit: %d\n", gasLimit)
+
+	// 等待交易上链，超时、执行失败或被替换时报告原因
+	receipt, err := waitMined(client, signedTx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		// 交易卡在交易池中：用相同的nonce和更高的手续费重新发送（加速）
+		log.Printf("Transaction not mined in %s, speeding up", minedTimeout)
+		signedTx, receipt, err = speedUp(client, sender, feeConfig, signedTx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for transaction: %w", err)
+	}
+
+	fmt.Println("\n=== Transaction Mined ===")
+	fmt.Printf("Transaction Hash: %s\n", signedTx.Hash().Hex())
+	fmt.Printf("Block Number: %d\n", receipt.BlockNumber)
+	fmt.Printf("Gas Used: %d\n", receipt.GasUsed)
+	fmt.Printf("Effective Gas Price: %s gwei\n", ethtx.FormatGwei(receipt.EffectiveGasPrice))
+	return receipt, nil
 }
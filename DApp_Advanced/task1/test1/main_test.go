@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"ethtx"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestSpeedUpReturnsMinedTransaction(t *testing.T) {
+	tests := []struct {
+		name string
+		// original 原交易是否送达节点（送达后立即出块）
+		original bool
+		// replacementLost 替换交易是否没有送达节点（发送结果不确定）
+		replacementLost bool
+		wantReplacement bool
+	}{
+		// 原交易卡住（没有送达节点），替换交易上链
+		{name: "replacement mined", wantReplacement: true},
+		// 原交易在加速前已经上链，替换交易因nonce过低无法发送
+		{name: "original mined before speed-up", original: true},
+		// 原交易上链，替换交易没有送达：等待替换交易时报告已替换，返回原交易
+		{name: "original mined after speed-up", original: true, replacementLost: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newPayoutFixture(t, hundredEther, "1")
+			chainID := big.NewInt(1337)
+			sender := ethtx.NewSender(f.client, ethtx.NewKeySigner(f.key), chainID)
+
+			var sent int
+			f.client.failSend = func(*types.Transaction) error {
+				sent++
+				if (sent == 1 && !tt.original) || (sent == 2 && tt.replacementLost) {
+					return ethtx.ErrSendUncertain
+				}
+				return nil
+			}
+			original, err := sender.SendTx(context.Background(), func(nonce uint64) (*types.Transaction, error) {
+				return types.NewTx(&types.DynamicFeeTx{
+					ChainID:   chainID,
+					Nonce:     nonce,
+					GasTipCap: big.NewInt(1e9),
+					GasFeeCap: big.NewInt(10e9),
+					Gas:       transferGasLimit,
+					To:        &f.recipients[0],
+					Value:     big.NewInt(1),
+				}), nil
+			})
+			if original == nil {
+				t.Fatalf("send original: %v", err)
+			}
+
+			mined, receipt, err := speedUp(f.client, sender, ethtx.DefaultFeeConfig(), original)
+			if err != nil {
+				t.Fatalf("speedUp: %v", err)
+			}
+			if got := mined.Hash() != original.Hash(); got != tt.wantReplacement {
+				t.Errorf("returned replacement = %v, want %v", got, tt.wantReplacement)
+			}
+			if receipt.TxHash != mined.Hash() {
+				t.Errorf("receipt for %s, returned transaction %s", receipt.TxHash.Hex(), mined.Hash().Hex())
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"ethtx"
+)
+
+// networkProfile 预置的网络配置
+type networkProfile struct {
+	ChainID    uint64
+	InfuraHost string // 为空时使用 DefaultURL
+	DefaultURL string
+}
+
+// networkProfiles 支持的网络，custom 需要通过 RPC_URL 和 CHAIN_ID 指定
+var networkProfiles = map[string]networkProfile{
+	"mainnet": {ChainID: 1, InfuraHost: "mainnet.infura.io"},
+	"sepolia": {ChainID: 11155111, InfuraHost: "sepolia.infura.io"},
+	"holesky": {ChainID: 17000, InfuraHost: "holesky.infura.io"},
+	"anvil":   {ChainID: 31337, DefaultURL: "http://127.0.0.1:8545"}, // foundry anvil
+	"dev":     {ChainID: 1337, DefaultURL: "http://127.0.0.1:8545"},  // geth --dev
+}
+
+// defaultNetwork 未指定网络时使用的网络
+const defaultNetwork = "sepolia"
+
+// network 解析后的网络：节点地址（按优先级排列，第一个失败时依次使用后面的）和期望的链ID
+type network struct {
+	Name    string
+	URLs    []string
+	ChainID *big.Int
+}
+
+// networkNames 返回支持的网络名称
+func networkNames() string {
+	names := make([]string, 0, len(networkProfiles)+1)
+	for name := range networkProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(append(names, "custom"), ", ")
+}
+
+// resolveNetwork 根据网络名称和环境变量确定节点地址和期望的链ID：
+// RPC_URL 覆盖预置的节点地址（例如使用其他节点服务商，可以用逗号分隔多个），RPC_FALLBACK_URLS 为追加的备用节点，
+// CHAIN_ID 只能在 custom 网络中使用，预置网络的链ID固定，避免配置错误时为其他链签名
+func resolveNetwork(name string, getenv func(string) string) (*network, error) {
+	if name == "" {
+		name = defaultNetwork
+	}
+	name = strings.ToLower(name)
+	rpcURLs := splitURLs(getenv("RPC_URL"))
+	fallbackURLs := splitURLs(getenv("RPC_FALLBACK_URLS"))
+
+	if name == "custom" {
+		chainIDStr := getenv("CHAIN_ID")
+		if len(rpcURLs) == 0 || chainIDStr == "" {
+			return nil, fmt.Errorf("network custom requires RPC_URL and CHAIN_ID")
+		}
+		chainID, err := strconv.ParseUint(chainIDStr, 10, 64)
+		if err != nil || chainID == 0 {
+			return nil, fmt.Errorf("CHAIN_ID: %q is not a positive integer", chainIDStr)
+		}
+		return &network{Name: name, URLs: append(rpcURLs, fallbackURLs...), ChainID: new(big.Int).SetUint64(chainID)}, nil
+	}
+
+	profile, ok := networkProfiles[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown network %q, want one of %s", name, networkNames())
+	}
+	if chainIDStr := getenv("CHAIN_ID"); chainIDStr != "" && chainIDStr != strconv.FormatUint(profile.ChainID, 10) {
+		return nil, fmt.Errorf("CHAIN_ID %s conflicts with network %s (chain ID %d); use -network custom for other chains", chainIDStr, name, profile.ChainID)
+	}
+
+	urls := rpcURLs
+	switch {
+	case len(urls) > 0:
+	case profile.InfuraHost != "":
+		apiKey := getenv("INFURA_API_KEY")
+		if apiKey == "" {
+			return nil, fmt.Errorf("network %s requires INFURA_API_KEY or RPC_URL", name)
+		}
+		urls = []string{fmt.Sprintf("https://%s/v3/%s", profile.InfuraHost, apiKey)}
+	default:
+		urls = []string{profile.DefaultURL}
+	}
+	return &network{Name: name, URLs: append(urls, fallbackURLs...), ChainID: new(big.Int).SetUint64(profile.ChainID)}, nil
+}
+
+// splitURLs 拆分逗号分隔的节点地址
+func splitURLs(value string) []string {
+	var urls []string
+	for _, url := range strings.Split(value, ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+// chainIDReader 查询节点链ID的接口
+type chainIDReader interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// verifyChainID 确认节点的链ID与网络配置一致，不一致时返回错误，必须在任何签名之前调用
+func (n *network) verifyChainID(ctx context.Context, client chainIDReader) error {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("get chain ID from %s: %w", n.redactedURL(), err)
+	}
+	if chainID.Cmp(n.ChainID) != 0 {
+		return fmt.Errorf("endpoint %s reports chain ID %s, but network %s expects %s; refusing to sign",
+			n.redactedURL(), chainID, n.Name, n.ChainID)
+	}
+	return nil
+}
+
+// redactedURL 返回隐藏了 Infura 等服务API密钥的节点地址，用于日志和错误信息
+func (n *network) redactedURL() string {
+	names := make([]string, len(n.URLs))
+	for i, url := range n.URLs {
+		names[i] = ethtx.EndpointName(url)
+	}
+	return strings.Join(names, ", ")
+}
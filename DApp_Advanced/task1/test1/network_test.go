@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func mapEnv(env map[string]string) func(string) string {
+	return func(key string) string { return env[key] }
+}
+
+func TestResolveNetwork(t *testing.T) {
+	tests := []struct {
+		name    string
+		network string
+		env     map[string]string
+		urls    string
+		chainID int64
+		err     string
+	}{
+		{name: "default", env: map[string]string{"INFURA_API_KEY": "key"}, urls: "https://sepolia.infura.io/v3/key", chainID: 11155111},
+		{name: "mainnet", network: "Mainnet", env: map[string]string{"INFURA_API_KEY": "key"}, urls: "https://mainnet.infura.io/v3/key", chainID: 1},
+		{name: "holesky", network: "holesky", env: map[string]string{"INFURA_API_KEY": "key"}, urls: "https://holesky.infura.io/v3/key", chainID: 17000},
+		{name: "anvil", network: "anvil", urls: "http://127.0.0.1:8545", chainID: 31337},
+		{name: "geth dev", network: "dev", urls: "http://127.0.0.1:8545", chainID: 1337},
+		{name: "rpc url override", network: "sepolia", env: map[string]string{"RPC_URL": "https://rpc.example.org"}, urls: "https://rpc.example.org", chainID: 11155111},
+		{name: "matching chain id", network: "sepolia", env: map[string]string{"RPC_URL": "https://rpc.example.org", "CHAIN_ID": "11155111"}, urls: "https://rpc.example.org", chainID: 11155111},
+		{name: "fallback urls", network: "mainnet", env: map[string]string{"INFURA_API_KEY": "key", "RPC_FALLBACK_URLS": "https://a.example.org, https://b.example.org"}, urls: "https://mainnet.infura.io/v3/key,https://a.example.org,https://b.example.org", chainID: 1},
+		{name: "rpc url list", network: "anvil", env: map[string]string{"RPC_URL": "http://127.0.0.1:8545,http://127.0.0.1:8546"}, urls: "http://127.0.0.1:8545,http://127.0.0.1:8546", chainID: 31337},
+		{name: "custom", network: "custom", env: map[string]string{"RPC_URL": "http://10.0.0.2:8545", "CHAIN_ID": "42161"}, urls: "http://10.0.0.2:8545", chainID: 42161},
+		{name: "missing infura key", network: "mainnet", err: "requires INFURA_API_KEY or RPC_URL"},
+		{name: "conflicting chain id", network: "sepolia", env: map[string]string{"INFURA_API_KEY": "key", "CHAIN_ID": "1"}, err: "conflicts with network sepolia"},
+		{name: "custom without chain id", network: "custom", env: map[string]string{"RPC_URL": "http://10.0.0.2:8545"}, err: "requires RPC_URL and CHAIN_ID"},
+		{name: "custom invalid chain id", network: "custom", env: map[string]string{"RPC_URL": "http://10.0.0.2:8545", "CHAIN_ID": "0x1"}, err: "not a positive integer"},
+		{name: "unknown", network: "goerli", err: "unknown network"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			net, err := resolveNetwork(tt.network, mapEnv(tt.env))
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("err = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if urls := strings.Join(net.URLs, ","); urls != tt.urls || net.ChainID.Int64() != tt.chainID {
+				t.Errorf("got %s chain %s, want %s chain %d", urls, net.ChainID, tt.urls, tt.chainID)
+			}
+		})
+	}
+}
+
+type chainIDFunc func(ctx context.Context) (*big.Int, error)
+
+func (f chainIDFunc) ChainID(ctx context.Context) (*big.Int, error) { return f(ctx) }
+
+func TestVerifyChainID(t *testing.T) {
+	net, err := resolveNetwork("sepolia", mapEnv(map[string]string{"INFURA_API_KEY": "secret"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	reports := func(id int64) chainIDFunc {
+		return func(context.Context) (*big.Int, error) { return big.NewInt(id), nil }
+	}
+
+	if err := net.verifyChainID(context.Background(), reports(11155111)); err != nil {
+		t.Errorf("matching chain: %v", err)
+	}
+
+	// 节点实际是主网时拒绝签名，错误信息中不包含API密钥
+	err = net.verifyChainID(context.Background(), reports(1))
+	if err == nil || !strings.Contains(err.Error(), "reports chain ID 1, but network sepolia expects 11155111") {
+		t.Errorf("mismatch: err = %v", err)
+	}
+	if err != nil && strings.Contains(err.Error(), "secret") {
+		t.Errorf("error leaks API key: %v", err)
+	}
+
+	failure := errors.New("connection refused")
+	err = net.verifyChainID(context.Background(), chainIDFunc(func(context.Context) (*big.Int, error) { return nil, failure }))
+	if !errors.Is(err, failure) {
+		t.Errorf("query failure: err = %v", err)
+	}
+}
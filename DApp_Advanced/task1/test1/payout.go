@@ -0,0 +1,693 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"ethtx"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// 付款结果状态
+const (
+	payoutUnpaid = "unpaid" // 尚未发送
+	payoutSent   = "sent"   // 已签名并记录交易哈希，等待上链（重新运行时先查询链上状态）
+	payoutPaid   = "paid"   // 交易执行成功
+	payoutFailed = "failed" // 交易执行失败（接收方合约回滚），加 -retry-failed 时重新发送
+	payoutReview = "review" // 无法确认交易是否还会上链（例如nonce被交易池中看不到的交易占用），需要人工核对，不会自动重发，重新运行时再次核对
+)
+
+// payoutUsage payout 命令的用法
+const payoutUsage = `用法: go run . payout [选项] <付款CSV>
+
+CSV每行为 地址,金额（ETH），地址必须是EIP-55校验和格式，可以有 address,amount 表头，# 开头的行为注释。
+结果写入结果文件，重新运行时跳过已付款的行，查询上次已发送交易的链上状态后继续。
+
+选项:
+`
+
+// payoutClient 批量付款需要的节点接口，*ethclient.Client 实现了该接口
+type payoutClient interface {
+	ethtx.TxClient
+	ethtx.ReceiptClient
+	ethtx.FeeSource
+	ethereum.GasEstimator
+	PendingBalanceAt(ctx context.Context, account common.Address) (*big.Int, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+}
+
+// payoutNode 在单个节点上核对已发送交易需要的查询，ethtx.EndpointClient 实现了该接口
+type payoutNode interface {
+	ethtx.ReceiptClient
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// payoutRow 付款CSV中的一行
+type payoutRow struct {
+	Line    int
+	Address common.Address
+	Amount  *big.Int // wei
+}
+
+// payoutResult 结果文件中的一行
+type payoutResult struct {
+	Address common.Address
+	Amount  *big.Int
+	Status  string
+	TxHash  common.Hash
+	Nonce   uint64
+	Block   uint64
+	Error   string
+}
+
+var payoutResultHeader = []string{"address", "amount_eth", "status", "tx_hash", "nonce", "block", "error"}
+
+// parseChecksumAddress 解析地址并校验EIP-55校验和，全小写或大小写错误的地址都会被拒绝，避免抄错地址
+func parseChecksumAddress(s string) (common.Address, error) {
+	if !strings.HasPrefix(s, "0x") || !common.IsHexAddress(s) {
+		return common.Address{}, fmt.Errorf("invalid address %q", s)
+	}
+	address := common.HexToAddress(s)
+	if address.Hex() != s {
+		return common.Address{}, fmt.Errorf("address %s does not match its EIP-55 checksum %s", s, address.Hex())
+	}
+	return address, nil
+}
+
+// readPayoutCSV 读取并校验付款CSV，返回所有行的错误
+func readPayoutCSV(r io.Reader) ([]payoutRow, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var (
+		rows  []payoutRow
+		errs  []error
+		lines = make(map[common.Address]int)
+	)
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		if first && strings.EqualFold(strings.TrimSpace(record[0]), "address") {
+			continue
+		}
+		if len(record) != 2 {
+			errs = append(errs, fmt.Errorf("line %d: want address,amount, got %d fields", line, len(record)))
+			continue
+		}
+
+		address, err := parseChecksumAddress(strings.TrimSpace(record[0]))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %w", line, err))
+			continue
+		}
+		amount, err := ethtx.ParseEther(strings.TrimSpace(record[1]))
+		if err == nil && amount.Sign() == 0 {
+			err = errors.New("amount must be positive")
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %w", line, err))
+			continue
+		}
+		// 结果文件按地址记录付款状态，同一地址只能出现一次
+		if previous, ok := lines[address]; ok {
+			errs = append(errs, fmt.Errorf("line %d: duplicate address %s (first on line %d)", line, address.Hex(), previous))
+			continue
+		}
+		lines[address] = line
+		rows = append(rows, payoutRow{Line: line, Address: address, Amount: amount})
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("no payout rows")
+	}
+	return rows, nil
+}
+
+// loadPayoutResults 读取结果文件，文件不存在时返回空结果
+// 结果文件决定哪些付款不再发送，任何一行无法解析都返回带行号的错误，而不是跳过该行导致重复付款
+func loadPayoutResults(path string) (map[common.Address]*payoutResult, error) {
+	results := make(map[common.Address]*payoutResult)
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return results, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read results %s: %w", path, err)
+		}
+		line, _ := reader.FieldPos(0)
+		if first {
+			if !slices.Equal(record, payoutResultHeader) {
+				return nil, fmt.Errorf("results %s line %d: want header %s", path, line, strings.Join(payoutResultHeader, ","))
+			}
+			continue
+		}
+		result, err := parsePayoutResult(record)
+		if err != nil {
+			return nil, fmt.Errorf("results %s line %d: %w", path, line, err)
+		}
+		if _, ok := results[result.Address]; ok {
+			return nil, fmt.Errorf("results %s line %d: duplicate address %s", path, line, result.Address.Hex())
+		}
+		results[result.Address] = result
+	}
+	return results, nil
+}
+
+// parsePayoutResult 解析结果文件中的一行
+func parsePayoutResult(record []string) (*payoutResult, error) {
+	if len(record) != len(payoutResultHeader) {
+		return nil, fmt.Errorf("want %d fields, got %d", len(payoutResultHeader), len(record))
+	}
+	if !common.IsHexAddress(record[0]) {
+		return nil, fmt.Errorf("invalid address %q", record[0])
+	}
+	amount, err := ethtx.ParseEther(record[1])
+	if err != nil {
+		return nil, err
+	}
+	switch record[2] {
+	case payoutUnpaid, payoutSent, payoutPaid, payoutFailed, payoutReview:
+	default:
+		return nil, fmt.Errorf("unknown status %q", record[2])
+	}
+	result := &payoutResult{
+		Address: common.HexToAddress(record[0]),
+		Amount:  amount,
+		Status:  record[2],
+		Error:   record[6],
+	}
+	if record[3] != "" {
+		hash, err := hexutil.Decode(record[3])
+		if err != nil || len(hash) != common.HashLength {
+			return nil, fmt.Errorf("invalid tx hash %q", record[3])
+		}
+		result.TxHash = common.BytesToHash(hash)
+		if result.Nonce, err = strconv.ParseUint(record[4], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid nonce %q", record[4])
+		}
+	}
+	if record[5] != "" {
+		if result.Block, err = strconv.ParseUint(record[5], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid block %q", record[5])
+		}
+	}
+	return result, nil
+}
+
+// savePayoutResults 按付款CSV的顺序写入结果文件，已从CSV中删除的地址的结果保留在最后；
+// 先写临时文件再重命名，程序中途退出不会留下半个文件
+func savePayoutResults(path string, rows []payoutRow, results map[common.Address]*payoutResult) error {
+	ordered := make([]*payoutResult, 0, len(results))
+	inCSV := make(map[common.Address]bool, len(rows))
+	for _, row := range rows {
+		ordered = append(ordered, results[row.Address])
+		inCSV[row.Address] = true
+	}
+	var removed []*payoutResult
+	for address, result := range results {
+		if !inCSV[address] {
+			removed = append(removed, result)
+		}
+	}
+	sort.Slice(removed, func(i, j int) bool { return removed[i].Address.Cmp(removed[j].Address) < 0 })
+	ordered = append(ordered, removed...)
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	writer := csv.NewWriter(tmp)
+	writer.Write(payoutResultHeader)
+	for _, result := range ordered {
+		var txHash, nonce, block string
+		if result.TxHash != (common.Hash{}) {
+			txHash, nonce = result.TxHash.Hex(), strconv.FormatUint(result.Nonce, 10)
+		}
+		if result.Block > 0 {
+			block = strconv.FormatUint(result.Block, 10)
+		}
+		writer.Write([]string{result.Address.Hex(), ethtx.FormatEther(result.Amount), result.Status, txHash, nonce, block, result.Error})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// payout 批量付款
+type payout struct {
+	client      payoutClient
+	signer      ethtx.Signer
+	chainID     *big.Int
+	fees        ethtx.FeeConfig
+	gas         ethtx.GasConfig
+	resultsPath string
+	retryFailed bool
+	waitTimeout time.Duration
+	out         io.Writer
+	// nodes 返回核对已发送交易时查询的所有节点，为nil时只查询 client
+	nodes func(ctx context.Context) ([]payoutNode, error)
+
+	rows    []payoutRow
+	results map[common.Address]*payoutResult
+}
+
+// save 保存结果文件
+func (p *payout) save() error {
+	if err := savePayoutResults(p.resultsPath, p.rows, p.results); err != nil {
+		return fmt.Errorf("save results %s: %w", p.resultsPath, err)
+	}
+	return nil
+}
+
+// run 核对上次运行的结果，校验余额后按顺序nonce发送剩余的付款并等待上链；dryRun 时只输出计划
+func (p *payout) run(ctx context.Context, rows []payoutRow, dryRun bool) error {
+	results, err := loadPayoutResults(p.resultsPath)
+	if err != nil {
+		return err
+	}
+	p.rows, p.results = rows, results
+
+	// 已记录的付款金额与CSV不一致时停止，避免少付或多付
+	for _, row := range rows {
+		result, ok := results[row.Address]
+		if !ok {
+			results[row.Address] = &payoutResult{Address: row.Address, Amount: row.Amount, Status: payoutUnpaid}
+			continue
+		}
+		if result.Status != payoutUnpaid && result.Amount.Cmp(row.Amount) != 0 {
+			return fmt.Errorf("line %d: %s has a %s payment of %s ETH in %s, but the CSV now says %s ETH",
+				row.Line, row.Address.Hex(), result.Status, ethtx.FormatEther(result.Amount), p.resultsPath, ethtx.FormatEther(row.Amount))
+		}
+		result.Amount = row.Amount
+	}
+
+	if err := p.reconcile(ctx); err != nil {
+		return err
+	}
+	if !dryRun {
+		if err := p.save(); err != nil {
+			return err
+		}
+	}
+
+	var todo []*payoutResult
+	for _, row := range rows {
+		result := results[row.Address]
+		if result.Status == payoutUnpaid || (result.Status == payoutFailed && p.retryFailed) {
+			todo = append(todo, result)
+		}
+	}
+
+	if len(todo) > 0 {
+		if err := p.checkBalance(ctx, todo); err != nil {
+			return err
+		}
+	}
+	if dryRun {
+		p.printSummary()
+		return nil
+	}
+
+	if len(todo) > 0 {
+		if err := p.send(ctx, todo); err != nil {
+			p.printSummary()
+			return err
+		}
+	}
+	// 包括上次运行发送、仍在交易池中的付款
+	p.waitAll(ctx)
+	p.printSummary()
+	return nil
+}
+
+// reconcile 在所有节点上核对上次运行已发送（以及待人工核对）交易的链上状态：
+//   - 任何节点上有回执的按回执更新
+//   - 某个节点上nonce已被上链交易使用而这笔交易没有回执，说明nonce被其他交易使用，这笔交易不会再上链，重新发送
+//   - 交易在某个节点的交易池中的继续等待
+//   - 所有节点的 pending nonce 都没有超过这笔交易的nonce且都查不到交易，说明交易从未送达或已被丢弃，重新发送
+//   - 其他情况（例如nonce被交易池中看不到的交易占用、部分节点查询失败）无法证明不会重复付款，标记为 review 等待人工核对
+func (p *payout) reconcile(ctx context.Context) error {
+	var nodes []payoutNode
+	for _, row := range p.rows {
+		result := p.results[row.Address]
+		if result.Status != payoutSent && result.Status != payoutReview {
+			continue
+		}
+		if nodes == nil {
+			var err error
+			if nodes, err = p.payoutNodes(ctx); err != nil {
+				return err
+			}
+		}
+
+		check := p.checkSent(ctx, nodes, result)
+		switch {
+		case check.receipt != nil:
+			p.applyReceipt(result, check.receipt)
+		case len(check.errs) > 0:
+			p.markReview(row, result, fmt.Sprintf("query failed: %v", errors.Join(check.errs...)))
+		case check.nonceUsed || check.nonceFree:
+			// 交易不会再上链，按新的nonce重新发送
+			result.Status, result.TxHash, result.Nonce, result.Error = payoutUnpaid, common.Hash{}, 0, ""
+		case check.pending:
+			result.Status, result.Error = payoutSent, ""
+		default:
+			p.markReview(row, result, fmt.Sprintf("transaction not found but nonce %d is taken by a pending transaction", result.Nonce))
+		}
+	}
+	return nil
+}
+
+// payoutNodes 返回核对已发送交易时查询的所有节点
+func (p *payout) payoutNodes(ctx context.Context) ([]payoutNode, error) {
+	if p.nodes == nil {
+		return []payoutNode{p.client}, nil
+	}
+	nodes, err := p.nodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("check sent payments: %w", err)
+	}
+	return nodes, nil
+}
+
+// sentCheck 已发送交易在所有节点上的核对结果
+type sentCheck struct {
+	receipt   *types.Receipt // 任何节点上查到的回执
+	pending   bool           // 交易在某个节点的交易池中
+	nonceUsed bool           // 某个节点上nonce已被其他上链交易使用
+	nonceFree bool           // 所有节点的 pending nonce 都没有超过交易的nonce
+	errs      []error        // 查询失败的节点
+}
+
+// checkSent 在每个节点上查询已发送交易的回执、交易池和账户nonce。
+// 每个节点先查询nonce再查询回执：nonce已超过交易的nonce而之后仍查不到回执，才能说明nonce被其他交易使用
+func (p *payout) checkSent(ctx context.Context, nodes []payoutNode, result *payoutResult) sentCheck {
+	from := p.signer.Address()
+	check := sentCheck{nonceFree: true}
+	for i, node := range nodes {
+		fail := func(err error) {
+			check.errs = append(check.errs, fmt.Errorf("node %d: %w", i+1, err))
+			check.nonceFree = false
+		}
+		nonce, err := node.NonceAt(ctx, from, nil)
+		if err != nil {
+			fail(fmt.Errorf("get nonce: %w", err))
+			continue
+		}
+		receipt, err := node.TransactionReceipt(ctx, result.TxHash)
+		if err == nil {
+			return sentCheck{receipt: receipt}
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			fail(fmt.Errorf("get receipt of %s: %w", result.TxHash.Hex(), err))
+			continue
+		}
+		if nonce > result.Nonce {
+			check.nonceUsed, check.nonceFree = true, false
+			continue
+		}
+		if _, _, err := node.TransactionByHash(ctx, result.TxHash); err == nil {
+			check.pending, check.nonceFree = true, false
+			continue
+		} else if !errors.Is(err, ethereum.NotFound) {
+			fail(fmt.Errorf("get transaction %s: %w", result.TxHash.Hex(), err))
+			continue
+		}
+		pendingNonce, err := node.PendingNonceAt(ctx, from)
+		if err != nil {
+			fail(fmt.Errorf("get pending nonce: %w", err))
+			continue
+		}
+		if pendingNonce > result.Nonce {
+			check.nonceFree = false
+		}
+	}
+	return check
+}
+
+// markReview 把无法确认的付款标记为待人工核对
+func (p *payout) markReview(row payoutRow, result *payoutResult, reason string) {
+	result.Status, result.Error = payoutReview, reason
+	fmt.Fprintf(p.out, "Line %d: cannot tell whether %s to %s will be mined (%s), check manually; it will not be resent\n",
+		row.Line, result.TxHash.Hex(), result.Address.Hex(), reason)
+}
+
+// applyReceipt 根据回执更新付款状态
+func (p *payout) applyReceipt(result *payoutResult, receipt *types.Receipt) {
+	result.Block = receipt.BlockNumber.Uint64()
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		result.Status, result.Error = payoutPaid, ""
+		return
+	}
+	result.Status, result.Error = payoutFailed, "execution failed"
+}
+
+// checkBalance 估算每笔付款的gas，发送前确认余额足够支付全部金额和最高手续费
+func (p *payout) checkBalance(ctx context.Context, todo []*payoutResult) error {
+	from := p.signer.Address()
+	fees, err := ethtx.SuggestFees(ctx, p.client, p.fees)
+	if err != nil {
+		return fmt.Errorf("suggest fees: %w", err)
+	}
+
+	total, maxFees := new(big.Int), new(big.Int)
+	for _, result := range todo {
+		gas, err := ethtx.EstimateGasLimit(ctx, p.client, p.gas, ethereum.CallMsg{
+			From:      from,
+			To:        &result.Address,
+			GasTipCap: fees.TipCap,
+			GasFeeCap: fees.FeeCap,
+			Value:     result.Amount,
+		})
+		if err != nil {
+			return fmt.Errorf("estimate gas for %s: %w", result.Address.Hex(), err)
+		}
+		total.Add(total, result.Amount)
+		maxFees.Add(maxFees, new(big.Int).Mul(new(big.Int).SetUint64(gas), fees.FeeCap))
+	}
+
+	balance, err := p.client.PendingBalanceAt(ctx, from)
+	if err != nil {
+		return fmt.Errorf("get balance: %w", err)
+	}
+	need := new(big.Int).Add(total, maxFees)
+
+	fmt.Fprintf(p.out, "From: %s\n", from.Hex())
+	fmt.Fprintf(p.out, "Payments to send: %d (%s ETH)\n", len(todo), ethtx.FormatEther(total))
+	fmt.Fprintf(p.out, "Max fees: %s ETH (%s)\n", ethtx.FormatEther(maxFees), fees)
+	fmt.Fprintf(p.out, "Balance: %s ETH\n", ethtx.FormatEther(balance))
+	if balance.Cmp(need) < 0 {
+		return fmt.Errorf("insufficient balance: have %s ETH, need up to %s ETH", ethtx.FormatEther(balance), ethtx.FormatEther(need))
+	}
+	return nil
+}
+
+// send 按顺序nonce发送付款，每笔交易签名后先写入结果文件再发送
+func (p *payout) send(ctx context.Context, todo []*payoutResult) error {
+	fees, err := ethtx.SuggestFees(ctx, p.client, p.fees)
+	if err != nil {
+		return fmt.Errorf("suggest fees: %w", err)
+	}
+	sender := ethtx.NewSender(p.client, p.signer, p.chainID)
+	from := sender.Address()
+
+	for _, result := range todo {
+		gas, err := ethtx.EstimateGasLimit(ctx, p.client, p.gas, ethereum.CallMsg{
+			From:      from,
+			To:        &result.Address,
+			GasTipCap: fees.TipCap,
+			GasFeeCap: fees.FeeCap,
+			Value:     result.Amount,
+		})
+		if err != nil {
+			return fmt.Errorf("estimate gas for %s: %w", result.Address.Hex(), err)
+		}
+
+		to := result.Address
+		tx, err := sender.SendTxRecorded(ctx, func(nonce uint64) (*types.Transaction, error) {
+			return types.NewTx(&types.DynamicFeeTx{
+				ChainID:   p.chainID,
+				Nonce:     nonce,
+				GasTipCap: fees.TipCap,
+				GasFeeCap: fees.FeeCap,
+				Gas:       gas,
+				To:        &to,
+				Value:     result.Amount,
+			}), nil
+		}, func(tx *types.Transaction) error {
+			result.Status, result.TxHash, result.Nonce, result.Block, result.Error = payoutSent, tx.Hash(), tx.Nonce(), 0, ""
+			return p.save()
+		})
+		if err != nil {
+			// 交易可能已经送达节点，保留记录的哈希，重新运行时按链上状态处理
+			return fmt.Errorf("send payment to %s: %w", result.Address.Hex(), err)
+		}
+		fmt.Fprintf(p.out, "Sent %s ETH to %s: %s (nonce %d)\n", ethtx.FormatEther(result.Amount), result.Address.Hex(), tx.Hash().Hex(), tx.Nonce())
+	}
+	return nil
+}
+
+// waitAll 等待所有已发送的付款上链，超时的付款保持 sent 状态，重新运行时继续核对
+func (p *payout) waitAll(ctx context.Context) {
+	for _, row := range p.rows {
+		result := p.results[row.Address]
+		if result.Status != payoutSent {
+			continue
+		}
+		tx, _, err := p.client.TransactionByHash(ctx, result.TxHash)
+		if err != nil {
+			fmt.Fprintf(p.out, "Line %d: get transaction %s: %v\n", row.Line, result.TxHash.Hex(), err)
+			continue
+		}
+
+		waitCtx, cancel := context.WithTimeout(ctx, p.waitTimeout)
+		receipt, err := ethtx.WaitMined(waitCtx, p.client, tx, 1)
+		cancel()
+		switch {
+		case err == nil:
+			p.applyReceipt(result, receipt)
+		case errors.Is(err, ethtx.ErrTxFailed):
+			result.Status, result.Error = payoutFailed, err.Error()
+		default:
+			fmt.Fprintf(p.out, "Line %d: %s not confirmed yet: %v\n", row.Line, result.TxHash.Hex(), err)
+			continue
+		}
+		if err := p.save(); err != nil {
+			fmt.Fprintln(p.out, err)
+		}
+	}
+}
+
+// printSummary 输出各状态的付款数量
+func (p *payout) printSummary() {
+	counts := make(map[string]int)
+	for _, row := range p.rows {
+		counts[p.results[row.Address].Status]++
+	}
+	fmt.Fprintf(p.out, "\n=== Payout Summary (%s) ===\n", p.resultsPath)
+	for _, status := range []string{payoutPaid, payoutSent, payoutFailed, payoutReview, payoutUnpaid} {
+		if counts[status] > 0 {
+			fmt.Fprintf(p.out, "%s: %d\n", status, counts[status])
+		}
+	}
+}
+
+// payoutCommand 处理 payout 命令
+func payoutCommand(client *ethtx.Client, chainID *big.Int, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("payout", flag.ExitOnError)
+	resultsPath := flags.String("results", "", "结果文件，默认为 <付款CSV>.results.csv")
+	dryRun := flags.Bool("dry-run", false, "只校验CSV和余额并输出计划，不发送交易")
+	retryFailed := flags.Bool("retry-failed", false, "重新发送执行失败的付款")
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), payoutUsage)
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return errors.New("missing payout CSV")
+	}
+	csvPath := flags.Arg(0)
+	if *resultsPath == "" {
+		*resultsPath = strings.TrimSuffix(csvPath, filepath.Ext(csvPath)) + ".results.csv"
+	}
+
+	file, err := os.Open(csvPath)
+	if err != nil {
+		return err
+	}
+	rows, err := readPayoutCSV(file)
+	file.Close()
+	if err != nil {
+		return fmt.Errorf("invalid payout CSV %s:\n%w", csvPath, err)
+	}
+
+	feeConfig, err := feeConfigFromEnv()
+	if err != nil {
+		return fmt.Errorf("invalid fee config: %w", err)
+	}
+	gasConfig, err := gasConfigFromEnv(transferGasLimit)
+	if err != nil {
+		return fmt.Errorf("invalid gas config: %w", err)
+	}
+	signer, err := signerFromEnv()
+	if err != nil {
+		return fmt.Errorf("failed to create signer: %w", err)
+	}
+	p := &payout{
+		client:      client,
+		signer:      signer,
+		chainID:     chainID,
+		fees:        feeConfig,
+		gas:         gasConfig,
+		resultsPath: *resultsPath,
+		retryFailed: *retryFailed,
+		waitTimeout: minedTimeout,
+		out:         out,
+		nodes: func(ctx context.Context) ([]payoutNode, error) {
+			endpoints, err := client.Endpoints(ctx)
+			if err != nil {
+				return nil, err
+			}
+			nodes := make([]payoutNode, len(endpoints))
+			for i, endpoint := range endpoints {
+				nodes[i] = endpoint
+			}
+			return nodes, nil
+		},
+	}
+	return p.run(context.Background(), rows, *dryRun)
+}
@@ -0,0 +1,379 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"ethtx"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+)
+
+func TestReadPayoutCSV(t *testing.T) {
+	a := common.HexToAddress("0x1f98C5751Ba74946B05e2cD73C5B0174dF2195d0")
+	b := common.HexToAddress("0x74B5057e77D4F58CcC70bF1c7dc9f8405BCc72f0")
+	rows, err := readPayoutCSV(strings.NewReader("address,amount\n# 测试网付款\n" + a.Hex() + ",0.5\n" + b.Hex() + ", 1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 || rows[0].Address != a || rows[0].Amount.String() != "500000000000000000" || rows[1].Line != 4 {
+		t.Errorf("rows = %+v", rows)
+	}
+
+	_, err = readPayoutCSV(strings.NewReader(strings.Join([]string{
+		strings.ToLower(a.Hex()) + ",1",                // 全小写，没有校验和
+		"0x1F98C5751Ba74946B05e2cD73C5B0174dF2195d0,1", // 校验和错误
+		b.Hex() + ",0",                     // 金额为0
+		b.Hex() + ",0.0000000000000000001", // 超过18位小数
+		b.Hex() + ",1,extra",               // 字段数错误
+		a.Hex() + ",1",
+		a.Hex() + ",2", // 重复地址
+	}, "\n")))
+	if err == nil {
+		t.Fatal("want validation errors")
+	}
+	for _, want := range []string{"line 1:", "line 2:", "line 3: amount must be positive", "line 4:", "line 5:", "line 7: duplicate address"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("errors missing %q:\n%v", want, err)
+		}
+	}
+
+	if _, err := readPayoutCSV(strings.NewReader("address,amount\n")); err == nil {
+		t.Error("empty CSV: want error")
+	}
+}
+
+func TestLoadPayoutResultsRejectsMalformedRows(t *testing.T) {
+	const header = "address,amount_eth,status,tx_hash,nonce,block,error\n"
+	a := "0x1f98C5751Ba74946B05e2cD73C5B0174dF2195d0"
+	paid := a + ",1,paid,0x" + strings.Repeat("ab", 32) + ",3,10,\n"
+	tests := []struct {
+		name, content, want string
+	}{
+		{"bad header", "address,amount\n", "line 1: want header"},
+		{"bad address", header + paid + "0x1234,1,unpaid,,,,\n", "line 3: invalid address"},
+		{"field count", header + a + ",1,unpaid\n", "line 2: want 7 fields"},
+		{"bad amount", header + a + ",abc,unpaid,,,,\n", "line 2:"},
+		{"unknown status", header + a + ",1,done,,,,\n", "line 2: unknown status"},
+		{"bad tx hash", header + a + ",1,sent,0x1234,3,,\n", "line 2: invalid tx hash"},
+		{"bad nonce", header + a + ",1,sent,0x" + strings.Repeat("ab", 32) + ",x,,\n", "line 2: invalid nonce"},
+		{"duplicate address", header + paid + paid, "line 3: duplicate address"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "results.csv")
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			// 跳过无法解析的行会把已付款的地址当作未付款，必须报错
+			if _, err := loadPayoutResults(path); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("err = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+// autoCommitClient 每笔交易发送后立即出块；failSend 返回错误时模拟发送失败（交易已签名并记录，但没有送达节点）
+type autoCommitClient struct {
+	simulated.Client
+	backend  *simulated.Backend
+	failSend func(tx *types.Transaction) error
+	sent     int
+}
+
+func (c *autoCommitClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if c.failSend != nil {
+		if err := c.failSend(tx); err != nil {
+			return err
+		}
+	}
+	if err := c.Client.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	c.sent++
+	c.backend.Commit()
+	return nil
+}
+
+type payoutFixture struct {
+	client     *autoCommitClient
+	key        *ecdsa.PrivateKey
+	recipients []common.Address
+	csv        string
+	results    string
+	nodes      []payoutNode // 不为nil时核对已发送交易时查询的节点
+}
+
+func newPayoutFixture(t *testing.T, funds *big.Int, amounts ...string) *payoutFixture {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend := simulated.NewBackend(types.GenesisAlloc{crypto.PubkeyToAddress(key.PublicKey): {Balance: funds}})
+	t.Cleanup(func() { backend.Close() })
+
+	dir := t.TempDir()
+	f := &payoutFixture{
+		client:  &autoCommitClient{Client: backend.Client(), backend: backend},
+		key:     key,
+		csv:     filepath.Join(dir, "payout.csv"),
+		results: filepath.Join(dir, "payout.results.csv"),
+	}
+	lines := []string{"address,amount"}
+	for _, amount := range amounts {
+		recipient, _ := crypto.GenerateKey()
+		address := crypto.PubkeyToAddress(recipient.PublicKey)
+		f.recipients = append(f.recipients, address)
+		lines = append(lines, address.Hex()+","+amount)
+	}
+	f.writeCSV(t, strings.Join(lines, "\n"))
+
+	// 模拟链在后台建立交易索引，索引完成前查询回执返回 "transaction indexing is in progress"，
+	// 核对已发送交易时会因此标记为待人工核对；先出一个块并等待索引完成
+	backend.Commit()
+	for {
+		_, err := f.client.TransactionReceipt(context.Background(), common.Hash{})
+		if errors.Is(err, ethereum.NotFound) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return f
+}
+
+func (f *payoutFixture) writeCSV(t *testing.T, content string) {
+	t.Helper()
+	if err := os.WriteFile(f.csv, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (f *payoutFixture) run(t *testing.T, dryRun bool) (string, error) {
+	t.Helper()
+	file, err := os.Open(f.csv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	rows, err := readPayoutCSV(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gas := ethtx.DefaultGasConfig()
+	gas.FallbackLimit = transferGasLimit
+	out := &bytes.Buffer{}
+	p := &payout{
+		client:      f.client,
+		signer:      ethtx.NewKeySigner(f.key),
+		chainID:     big.NewInt(1337),
+		fees:        ethtx.DefaultFeeConfig(),
+		gas:         gas,
+		resultsPath: f.results,
+		waitTimeout: 10 * time.Second,
+		out:         out,
+	}
+	if f.nodes != nil {
+		p.nodes = func(context.Context) ([]payoutNode, error) { return f.nodes, nil }
+	}
+	err = p.run(context.Background(), rows, dryRun)
+	return out.String(), err
+}
+
+func (f *payoutFixture) balance(t *testing.T, address common.Address) string {
+	t.Helper()
+	balance, err := f.client.BalanceAt(context.Background(), address, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ethtx.FormatEther(balance)
+}
+
+func (f *payoutFixture) statuses(t *testing.T) []string {
+	t.Helper()
+	results, err := loadPayoutResults(f.results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var statuses []string
+	for _, recipient := range f.recipients {
+		statuses = append(statuses, results[recipient].Status)
+	}
+	return statuses
+}
+
+var hundredEther = new(big.Int).Mul(big.NewInt(100), big.NewInt(1e18))
+
+func TestPayoutSendsOnceAndSkipsPaidRowsOnRerun(t *testing.T) {
+	f := newPayoutFixture(t, hundredEther, "0.5", "1.25", "2")
+
+	if out, err := f.run(t, true); err != nil || f.client.sent != 0 || !strings.Contains(out, "Payments to send: 3 (3.75 ETH)") {
+		t.Fatalf("dry run: sent %d, err %v, output:\n%s", f.client.sent, err, out)
+	}
+	if _, err := os.Stat(f.results); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("dry run wrote results file: %v", err)
+	}
+
+	if out, err := f.run(t, false); err != nil {
+		t.Fatalf("run: %v\n%s", err, out)
+	}
+	if got := strings.Join(f.statuses(t), ","); got != "paid,paid,paid" {
+		t.Errorf("statuses = %s", got)
+	}
+	for i, want := range []string{"0.5", "1.25", "2"} {
+		if got := f.balance(t, f.recipients[i]); got != want {
+			t.Errorf("recipient %d balance = %s, want %s", i, got, want)
+		}
+	}
+
+	// 重新运行不再发送任何交易
+	out, err := f.run(t, false)
+	if err != nil || f.client.sent != 3 || !strings.Contains(out, "paid: 3") {
+		t.Errorf("rerun: sent %d, err %v, output:\n%s", f.client.sent, err, out)
+	}
+
+	// 已付款的金额与CSV不一致时拒绝继续
+	f.writeCSV(t, "address,amount\n"+f.recipients[0].Hex()+",0.6\n")
+	if _, err := f.run(t, false); err == nil || !strings.Contains(err.Error(), "CSV now says 0.6 ETH") {
+		t.Errorf("changed amount: err = %v", err)
+	}
+}
+
+func TestPayoutResumesAfterInterruptedSend(t *testing.T) {
+	f := newPayoutFixture(t, hundredEther, "1", "2", "3")
+
+	// 第二笔交易签名并记录后发送失败，模拟程序在发送过程中退出
+	var calls int
+	f.client.failSend = func(*types.Transaction) error {
+		if calls++; calls == 2 {
+			return errors.New("connection reset by peer")
+		}
+		return nil
+	}
+	if _, err := f.run(t, false); err == nil {
+		t.Fatal("want send error")
+	}
+	// 发送失败时立即停止，第一笔已送达、第二笔只记录了哈希，都留到重新运行时核对
+	if got := strings.Join(f.statuses(t), ","); got != "sent,sent,unpaid" {
+		t.Fatalf("statuses after interruption = %s", got)
+	}
+
+	// 重新运行：第一笔按回执标记为已付款；第二笔记录的交易不存在且nonce未被使用，重新发送；
+	// 每个地址只收到一次付款
+	f.client.failSend = nil
+	if out, err := f.run(t, false); err != nil {
+		t.Fatalf("resume: %v\n%s", err, out)
+	}
+	if got := strings.Join(f.statuses(t), ","); got != "paid,paid,paid" {
+		t.Errorf("statuses after resume = %s", got)
+	}
+	for i, want := range []string{"1", "2", "3"} {
+		if got := f.balance(t, f.recipients[i]); got != want {
+			t.Errorf("recipient %d balance = %s, want %s", i, got, want)
+		}
+	}
+	if f.client.sent != 3 {
+		t.Errorf("sent %d transactions, want 3", f.client.sent)
+	}
+}
+
+// hiddenPoolNode 交易池中有一笔占用nonce的交易，但按哈希查询不到（例如交易只在该节点的私有交易池中）
+type hiddenPoolNode struct {
+	payoutNode
+}
+
+func (n hiddenPoolNode) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	nonce, err := n.payoutNode.PendingNonceAt(ctx, account)
+	return nonce + 1, err
+}
+
+func TestPayoutDoesNotResendWhenNonceIsTakenByInvisibleTx(t *testing.T) {
+	f := newPayoutFixture(t, hundredEther, "1")
+	f.client.failSend = func(*types.Transaction) error { return errors.New("connection reset by peer") }
+	if _, err := f.run(t, false); err == nil {
+		t.Fatal("want send error")
+	}
+
+	// 记录的交易查不到，但另一个节点的 pending nonce 说明nonce已被交易池中的交易占用，不能重发
+	f.client.failSend = nil
+	f.nodes = []payoutNode{f.client, hiddenPoolNode{f.client}}
+	for i := 0; i < 2; i++ {
+		out, err := f.run(t, false)
+		if err != nil || !strings.Contains(out, "nonce 0 is taken by a pending transaction") || !strings.Contains(out, "review: 1") {
+			t.Fatalf("run %d: err %v, output:\n%s", i, err, out)
+		}
+		if got := strings.Join(f.statuses(t), ","); got != "review" || f.client.sent != 0 {
+			t.Fatalf("run %d: statuses = %s, sent %d", i, got, f.client.sent)
+		}
+	}
+
+	// 待核对的行每次运行都重新核对：交易池中的交易消失、nonce确认未被使用后才重新发送，只付款一次
+	f.nodes = []payoutNode{f.client}
+	out, err := f.run(t, false)
+	if err != nil {
+		t.Fatalf("resume: %v\n%s", err, out)
+	}
+	if got := strings.Join(f.statuses(t), ","); got != "paid" || f.client.sent != 1 || f.balance(t, f.recipients[0]) != "1" {
+		t.Errorf("statuses = %s, sent %d, balance %s, output:\n%s", got, f.client.sent, f.balance(t, f.recipients[0]), out)
+	}
+}
+
+func TestPayoutResendsWhenNonceIsUsedByAnotherTx(t *testing.T) {
+	f := newPayoutFixture(t, hundredEther, "1")
+	f.client.failSend = func(*types.Transaction) error { return errors.New("connection reset by peer") }
+	if _, err := f.run(t, false); err == nil {
+		t.Fatal("want send error")
+	}
+
+	// 同一账户的另一笔交易使用了记录的nonce并已上链，记录的交易不会再上链
+	ctx := context.Background()
+	fees, err := ethtx.SuggestFees(ctx, f.client, ethtx.DefaultFeeConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := common.HexToAddress("0x00000000000000000000000000000000000000c1")
+	tx, err := types.SignNewTx(f.key, types.LatestSignerForChainID(big.NewInt(1337)), &types.DynamicFeeTx{
+		ChainID: big.NewInt(1337), Nonce: 0, GasTipCap: fees.TipCap, GasFeeCap: fees.FeeCap, Gas: transferGasLimit, To: &other, Value: big.NewInt(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.client.Client.SendTransaction(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+	f.client.backend.Commit()
+
+	f.client.failSend = nil
+	out, err := f.run(t, false)
+	if err != nil {
+		t.Fatalf("resume: %v\n%s", err, out)
+	}
+	if got := strings.Join(f.statuses(t), ","); got != "paid" || f.balance(t, f.recipients[0]) != "1" {
+		t.Errorf("statuses = %s, balance %s, output:\n%s", got, f.balance(t, f.recipients[0]), out)
+	}
+}
+
+func TestPayoutChecksBalanceBeforeSending(t *testing.T) {
+	f := newPayoutFixture(t, big.NewInt(1e18), "0.5", "0.5")
+
+	_, err := f.run(t, false)
+	if err == nil || !strings.Contains(err.Error(), "insufficient balance") {
+		t.Fatalf("err = %v, want insufficient balance", err)
+	}
+	if f.client.sent != 0 {
+		t.Errorf("sent %d transactions before failing the balance check", f.client.sent)
+	}
+}
@@ -0,0 +1,871 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package Auction
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// AuctionFactoryMetaData contains all meta data concerning the AuctionFactory contract.
+var AuctionFactoryMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_ethUsdPriceFeed\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"auctionContract\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\",\"indexed\":true}],\"name\":\"AuctionContractCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"auctionContracts\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"auctionCreators\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"createAuctionContract\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ethUsdPriceFeed\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getAllAuctionContracts\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getAuctionContractCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"auctionContract\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"creator\",\"type\":\"address\"}],\"name\":\"isAuctionCreator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_ethUsdPriceFeed\",\"type\":\"address\"}],\"name\":\"setEthUsdPriceFeed\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"priceFeed\",\"type\":\"address\"}],\"name\":\"setTokenPriceFeed\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"supportedTokens\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"tokenUsdPriceFeeds\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// AuctionFactoryABI is the input ABI used to generate the binding from.
+// Deprecated: Use AuctionFactoryMetaData.ABI instead.
+var AuctionFactoryABI = AuctionFactoryMetaData.ABI
+
+// AuctionFactory is an auto generated Go binding around an Ethereum contract.
+type AuctionFactory struct {
+	AuctionFactoryCaller     // Read-only binding to the contract
+	AuctionFactoryTransactor // Write-only binding to the contract
+	AuctionFactoryFilterer   // Log filterer for contract events
+}
+
+// AuctionFactoryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type AuctionFactoryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuctionFactoryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type AuctionFactoryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuctionFactoryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type AuctionFactoryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AuctionFactorySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type AuctionFactorySession struct {
+	Contract     *AuctionFactory   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// AuctionFactoryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type AuctionFactoryCallerSession struct {
+	Contract *AuctionFactoryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// AuctionFactoryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type AuctionFactoryTransactorSession struct {
+	Contract     *AuctionFactoryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// AuctionFactoryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type AuctionFactoryRaw struct {
+	Contract *AuctionFactory // Generic contract binding to access the raw methods on
+}
+
+// AuctionFactoryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type AuctionFactoryCallerRaw struct {
+	Contract *AuctionFactoryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// AuctionFactoryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type AuctionFactoryTransactorRaw struct {
+	Contract *AuctionFactoryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewAuctionFactory creates a new instance of AuctionFactory, bound to a specific deployed contract.
+func NewAuctionFactory(address common.Address, backend bind.ContractBackend) (*AuctionFactory, error) {
+	contract, err := bindAuctionFactory(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactory{AuctionFactoryCaller: AuctionFactoryCaller{contract: contract}, AuctionFactoryTransactor: AuctionFactoryTransactor{contract: contract}, AuctionFactoryFilterer: AuctionFactoryFilterer{contract: contract}}, nil
+}
+
+// NewAuctionFactoryCaller creates a new read-only instance of AuctionFactory, bound to a specific deployed contract.
+func NewAuctionFactoryCaller(address common.Address, caller bind.ContractCaller) (*AuctionFactoryCaller, error) {
+	contract, err := bindAuctionFactory(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryCaller{contract: contract}, nil
+}
+
+// NewAuctionFactoryTransactor creates a new write-only instance of AuctionFactory, bound to a specific deployed contract.
+func NewAuctionFactoryTransactor(address common.Address, transactor bind.ContractTransactor) (*AuctionFactoryTransactor, error) {
+	contract, err := bindAuctionFactory(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryTransactor{contract: contract}, nil
+}
+
+// NewAuctionFactoryFilterer creates a new log filterer instance of AuctionFactory, bound to a specific deployed contract.
+func NewAuctionFactoryFilterer(address common.Address, filterer bind.ContractFilterer) (*AuctionFactoryFilterer, error) {
+	contract, err := bindAuctionFactory(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryFilterer{contract: contract}, nil
+}
+
+// bindAuctionFactory binds a generic wrapper to an already deployed contract.
+func bindAuctionFactory(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := AuctionFactoryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AuctionFactory *AuctionFactoryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AuctionFactory.Contract.AuctionFactoryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AuctionFactory *AuctionFactoryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.AuctionFactoryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AuctionFactory *AuctionFactoryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.AuctionFactoryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AuctionFactory *AuctionFactoryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AuctionFactory.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AuctionFactory *AuctionFactoryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AuctionFactory *AuctionFactoryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.contract.Transact(opts, method, params...)
+}
+
+// AuctionContracts is a free data retrieval call binding the contract method 0xa4ed8789.
+//
+// Solidity: function auctionContracts(uint256 ) view returns(address)
+func (_AuctionFactory *AuctionFactoryCaller) AuctionContracts(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "auctionContracts", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// AuctionContracts is a free data retrieval call binding the contract method 0xa4ed8789.
+//
+// Solidity: function auctionContracts(uint256 ) view returns(address)
+func (_AuctionFactory *AuctionFactorySession) AuctionContracts(arg0 *big.Int) (common.Address, error) {
+	return _AuctionFactory.Contract.AuctionContracts(&_AuctionFactory.CallOpts, arg0)
+}
+
+// AuctionContracts is a free data retrieval call binding the contract method 0xa4ed8789.
+//
+// Solidity: function auctionContracts(uint256 ) view returns(address)
+func (_AuctionFactory *AuctionFactoryCallerSession) AuctionContracts(arg0 *big.Int) (common.Address, error) {
+	return _AuctionFactory.Contract.AuctionContracts(&_AuctionFactory.CallOpts, arg0)
+}
+
+// AuctionCreators is a free data retrieval call binding the contract method 0x00e90745.
+//
+// Solidity: function auctionCreators(address ) view returns(address)
+func (_AuctionFactory *AuctionFactoryCaller) AuctionCreators(opts *bind.CallOpts, arg0 common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "auctionCreators", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// AuctionCreators is a free data retrieval call binding the contract method 0x00e90745.
+//
+// Solidity: function auctionCreators(address ) view returns(address)
+func (_AuctionFactory *AuctionFactorySession) AuctionCreators(arg0 common.Address) (common.Address, error) {
+	return _AuctionFactory.Contract.AuctionCreators(&_AuctionFactory.CallOpts, arg0)
+}
+
+// AuctionCreators is a free data retrieval call binding the contract method 0x00e90745.
+//
+// Solidity: function auctionCreators(address ) view returns(address)
+func (_AuctionFactory *AuctionFactoryCallerSession) AuctionCreators(arg0 common.Address) (common.Address, error) {
+	return _AuctionFactory.Contract.AuctionCreators(&_AuctionFactory.CallOpts, arg0)
+}
+
+// EthUsdPriceFeed is a free data retrieval call binding the contract method 0x42f6fb29.
+//
+// Solidity: function ethUsdPriceFeed() view returns(address)
+func (_AuctionFactory *AuctionFactoryCaller) EthUsdPriceFeed(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "ethUsdPriceFeed")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// EthUsdPriceFeed is a free data retrieval call binding the contract method 0x42f6fb29.
+//
+// Solidity: function ethUsdPriceFeed() view returns(address)
+func (_AuctionFactory *AuctionFactorySession) EthUsdPriceFeed() (common.Address, error) {
+	return _AuctionFactory.Contract.EthUsdPriceFeed(&_AuctionFactory.CallOpts)
+}
+
+// EthUsdPriceFeed is a free data retrieval call binding the contract method 0x42f6fb29.
+//
+// Solidity: function ethUsdPriceFeed() view returns(address)
+func (_AuctionFactory *AuctionFactoryCallerSession) EthUsdPriceFeed() (common.Address, error) {
+	return _AuctionFactory.Contract.EthUsdPriceFeed(&_AuctionFactory.CallOpts)
+}
+
+// GetAllAuctionContracts is a free data retrieval call binding the contract method 0xfa6a4125.
+//
+// Solidity: function getAllAuctionContracts() view returns(address[])
+func (_AuctionFactory *AuctionFactoryCaller) GetAllAuctionContracts(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "getAllAuctionContracts")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetAllAuctionContracts is a free data retrieval call binding the contract method 0xfa6a4125.
+//
+// Solidity: function getAllAuctionContracts() view returns(address[])
+func (_AuctionFactory *AuctionFactorySession) GetAllAuctionContracts() ([]common.Address, error) {
+	return _AuctionFactory.Contract.GetAllAuctionContracts(&_AuctionFactory.CallOpts)
+}
+
+// GetAllAuctionContracts is a free data retrieval call binding the contract method 0xfa6a4125.
+//
+// Solidity: function getAllAuctionContracts() view returns(address[])
+func (_AuctionFactory *AuctionFactoryCallerSession) GetAllAuctionContracts() ([]common.Address, error) {
+	return _AuctionFactory.Contract.GetAllAuctionContracts(&_AuctionFactory.CallOpts)
+}
+
+// GetAuctionContractCount is a free data retrieval call binding the contract method 0x279b9a2b.
+//
+// Solidity: function getAuctionContractCount() view returns(uint256)
+func (_AuctionFactory *AuctionFactoryCaller) GetAuctionContractCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "getAuctionContractCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetAuctionContractCount is a free data retrieval call binding the contract method 0x279b9a2b.
+//
+// Solidity: function getAuctionContractCount() view returns(uint256)
+func (_AuctionFactory *AuctionFactorySession) GetAuctionContractCount() (*big.Int, error) {
+	return _AuctionFactory.Contract.GetAuctionContractCount(&_AuctionFactory.CallOpts)
+}
+
+// GetAuctionContractCount is a free data retrieval call binding the contract method 0x279b9a2b.
+//
+// Solidity: function getAuctionContractCount() view returns(uint256)
+func (_AuctionFactory *AuctionFactoryCallerSession) GetAuctionContractCount() (*big.Int, error) {
+	return _AuctionFactory.Contract.GetAuctionContractCount(&_AuctionFactory.CallOpts)
+}
+
+// IsAuctionCreator is a free data retrieval call binding the contract method 0xaac548ec.
+//
+// Solidity: function isAuctionCreator(address auctionContract, address creator) view returns(bool)
+func (_AuctionFactory *AuctionFactoryCaller) IsAuctionCreator(opts *bind.CallOpts, auctionContract common.Address, creator common.Address) (bool, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "isAuctionCreator", auctionContract, creator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsAuctionCreator is a free data retrieval call binding the contract method 0xaac548ec.
+//
+// Solidity: function isAuctionCreator(address auctionContract, address creator) view returns(bool)
+func (_AuctionFactory *AuctionFactorySession) IsAuctionCreator(auctionContract common.Address, creator common.Address) (bool, error) {
+	return _AuctionFactory.Contract.IsAuctionCreator(&_AuctionFactory.CallOpts, auctionContract, creator)
+}
+
+// IsAuctionCreator is a free data retrieval call binding the contract method 0xaac548ec.
+//
+// Solidity: function isAuctionCreator(address auctionContract, address creator) view returns(bool)
+func (_AuctionFactory *AuctionFactoryCallerSession) IsAuctionCreator(auctionContract common.Address, creator common.Address) (bool, error) {
+	return _AuctionFactory.Contract.IsAuctionCreator(&_AuctionFactory.CallOpts, auctionContract, creator)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_AuctionFactory *AuctionFactoryCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_AuctionFactory *AuctionFactorySession) Owner() (common.Address, error) {
+	return _AuctionFactory.Contract.Owner(&_AuctionFactory.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_AuctionFactory *AuctionFactoryCallerSession) Owner() (common.Address, error) {
+	return _AuctionFactory.Contract.Owner(&_AuctionFactory.CallOpts)
+}
+
+// SupportedTokens is a free data retrieval call binding the contract method 0xc6255626.
+//
+// Solidity: function supportedTokens(uint256 ) view returns(address)
+func (_AuctionFactory *AuctionFactoryCaller) SupportedTokens(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "supportedTokens", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SupportedTokens is a free data retrieval call binding the contract method 0xc6255626.
+//
+// Solidity: function supportedTokens(uint256 ) view returns(address)
+func (_AuctionFactory *AuctionFactorySession) SupportedTokens(arg0 *big.Int) (common.Address, error) {
+	return _AuctionFactory.Contract.SupportedTokens(&_AuctionFactory.CallOpts, arg0)
+}
+
+// SupportedTokens is a free data retrieval call binding the contract method 0xc6255626.
+//
+// Solidity: function supportedTokens(uint256 ) view returns(address)
+func (_AuctionFactory *AuctionFactoryCallerSession) SupportedTokens(arg0 *big.Int) (common.Address, error) {
+	return _AuctionFactory.Contract.SupportedTokens(&_AuctionFactory.CallOpts, arg0)
+}
+
+// TokenUsdPriceFeeds is a free data retrieval call binding the contract method 0x628a9c96.
+//
+// Solidity: function tokenUsdPriceFeeds(address ) view returns(address)
+func (_AuctionFactory *AuctionFactoryCaller) TokenUsdPriceFeeds(opts *bind.CallOpts, arg0 common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _AuctionFactory.contract.Call(opts, &out, "tokenUsdPriceFeeds", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// TokenUsdPriceFeeds is a free data retrieval call binding the contract method 0x628a9c96.
+//
+// Solidity: function tokenUsdPriceFeeds(address ) view returns(address)
+func (_AuctionFactory *AuctionFactorySession) TokenUsdPriceFeeds(arg0 common.Address) (common.Address, error) {
+	return _AuctionFactory.Contract.TokenUsdPriceFeeds(&_AuctionFactory.CallOpts, arg0)
+}
+
+// TokenUsdPriceFeeds is a free data retrieval call binding the contract method 0x628a9c96.
+//
+// Solidity: function tokenUsdPriceFeeds(address ) view returns(address)
+func (_AuctionFactory *AuctionFactoryCallerSession) TokenUsdPriceFeeds(arg0 common.Address) (common.Address, error) {
+	return _AuctionFactory.Contract.TokenUsdPriceFeeds(&_AuctionFactory.CallOpts, arg0)
+}
+
+// CreateAuctionContract is a paid mutator transaction binding the contract method 0x485bb9b2.
+//
+// Solidity: function createAuctionContract() returns(address)
+func (_AuctionFactory *AuctionFactoryTransactor) CreateAuctionContract(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "createAuctionContract")
+}
+
+// CreateAuctionContract is a paid mutator transaction binding the contract method 0x485bb9b2.
+//
+// Solidity: function createAuctionContract() returns(address)
+func (_AuctionFactory *AuctionFactorySession) CreateAuctionContract() (*types.Transaction, error) {
+	return _AuctionFactory.Contract.CreateAuctionContract(&_AuctionFactory.TransactOpts)
+}
+
+// CreateAuctionContract is a paid mutator transaction binding the contract method 0x485bb9b2.
+//
+// Solidity: function createAuctionContract() returns(address)
+func (_AuctionFactory *AuctionFactoryTransactorSession) CreateAuctionContract() (*types.Transaction, error) {
+	return _AuctionFactory.Contract.CreateAuctionContract(&_AuctionFactory.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_AuctionFactory *AuctionFactoryTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_AuctionFactory *AuctionFactorySession) RenounceOwnership() (*types.Transaction, error) {
+	return _AuctionFactory.Contract.RenounceOwnership(&_AuctionFactory.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_AuctionFactory *AuctionFactoryTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _AuctionFactory.Contract.RenounceOwnership(&_AuctionFactory.TransactOpts)
+}
+
+// SetEthUsdPriceFeed is a paid mutator transaction binding the contract method 0xe293dcbf.
+//
+// Solidity: function setEthUsdPriceFeed(address _ethUsdPriceFeed) returns()
+func (_AuctionFactory *AuctionFactoryTransactor) SetEthUsdPriceFeed(opts *bind.TransactOpts, _ethUsdPriceFeed common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "setEthUsdPriceFeed", _ethUsdPriceFeed)
+}
+
+// SetEthUsdPriceFeed is a paid mutator transaction binding the contract method 0xe293dcbf.
+//
+// Solidity: function setEthUsdPriceFeed(address _ethUsdPriceFeed) returns()
+func (_AuctionFactory *AuctionFactorySession) SetEthUsdPriceFeed(_ethUsdPriceFeed common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.SetEthUsdPriceFeed(&_AuctionFactory.TransactOpts, _ethUsdPriceFeed)
+}
+
+// SetEthUsdPriceFeed is a paid mutator transaction binding the contract method 0xe293dcbf.
+//
+// Solidity: function setEthUsdPriceFeed(address _ethUsdPriceFeed) returns()
+func (_AuctionFactory *AuctionFactoryTransactorSession) SetEthUsdPriceFeed(_ethUsdPriceFeed common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.SetEthUsdPriceFeed(&_AuctionFactory.TransactOpts, _ethUsdPriceFeed)
+}
+
+// SetTokenPriceFeed is a paid mutator transaction binding the contract method 0x674417ae.
+//
+// Solidity: function setTokenPriceFeed(address token, address priceFeed) returns()
+func (_AuctionFactory *AuctionFactoryTransactor) SetTokenPriceFeed(opts *bind.TransactOpts, token common.Address, priceFeed common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "setTokenPriceFeed", token, priceFeed)
+}
+
+// SetTokenPriceFeed is a paid mutator transaction binding the contract method 0x674417ae.
+//
+// Solidity: function setTokenPriceFeed(address token, address priceFeed) returns()
+func (_AuctionFactory *AuctionFactorySession) SetTokenPriceFeed(token common.Address, priceFeed common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.SetTokenPriceFeed(&_AuctionFactory.TransactOpts, token, priceFeed)
+}
+
+// SetTokenPriceFeed is a paid mutator transaction binding the contract method 0x674417ae.
+//
+// Solidity: function setTokenPriceFeed(address token, address priceFeed) returns()
+func (_AuctionFactory *AuctionFactoryTransactorSession) SetTokenPriceFeed(token common.Address, priceFeed common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.SetTokenPriceFeed(&_AuctionFactory.TransactOpts, token, priceFeed)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_AuctionFactory *AuctionFactoryTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_AuctionFactory *AuctionFactorySession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.TransferOwnership(&_AuctionFactory.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_AuctionFactory *AuctionFactoryTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _AuctionFactory.Contract.TransferOwnership(&_AuctionFactory.TransactOpts, newOwner)
+}
+
+// AuctionFactoryAuctionContractCreatedIterator is returned from FilterAuctionContractCreated and is used to iterate over the raw logs and unpacked data for AuctionContractCreated events raised by the AuctionFactory contract.
+type AuctionFactoryAuctionContractCreatedIterator struct {
+	Event *AuctionFactoryAuctionContractCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionFactoryAuctionContractCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionFactoryAuctionContractCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionFactoryAuctionContractCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionFactoryAuctionContractCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionFactoryAuctionContractCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionFactoryAuctionContractCreated represents a AuctionContractCreated event raised by the AuctionFactory contract.
+type AuctionFactoryAuctionContractCreated struct {
+	AuctionContract common.Address
+	Creator         common.Address
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterAuctionContractCreated is a free log retrieval operation binding the contract event 0x520f8f1a1f42a47717ebe04dbad1bb98ff4120e505463a83b0b35ee9c0d6636c.
+//
+// Solidity: event AuctionContractCreated(address indexed auctionContract, address indexed creator)
+func (_AuctionFactory *AuctionFactoryFilterer) FilterAuctionContractCreated(opts *bind.FilterOpts, auctionContract []common.Address, creator []common.Address) (*AuctionFactoryAuctionContractCreatedIterator, error) {
+
+	var auctionContractRule []interface{}
+	for _, auctionContractItem := range auctionContract {
+		auctionContractRule = append(auctionContractRule, auctionContractItem)
+	}
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _AuctionFactory.contract.FilterLogs(opts, "AuctionContractCreated", auctionContractRule, creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryAuctionContractCreatedIterator{contract: _AuctionFactory.contract, event: "AuctionContractCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchAuctionContractCreated is a free log subscription operation binding the contract event 0x520f8f1a1f42a47717ebe04dbad1bb98ff4120e505463a83b0b35ee9c0d6636c.
+//
+// Solidity: event AuctionContractCreated(address indexed auctionContract, address indexed creator)
+func (_AuctionFactory *AuctionFactoryFilterer) WatchAuctionContractCreated(opts *bind.WatchOpts, sink chan<- *AuctionFactoryAuctionContractCreated, auctionContract []common.Address, creator []common.Address) (event.Subscription, error) {
+
+	var auctionContractRule []interface{}
+	for _, auctionContractItem := range auctionContract {
+		auctionContractRule = append(auctionContractRule, auctionContractItem)
+	}
+	var creatorRule []interface{}
+	for _, creatorItem := range creator {
+		creatorRule = append(creatorRule, creatorItem)
+	}
+
+	logs, sub, err := _AuctionFactory.contract.WatchLogs(opts, "AuctionContractCreated", auctionContractRule, creatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionFactoryAuctionContractCreated)
+				if err := _AuctionFactory.contract.UnpackLog(event, "AuctionContractCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAuctionContractCreated is a log parse operation binding the contract event 0x520f8f1a1f42a47717ebe04dbad1bb98ff4120e505463a83b0b35ee9c0d6636c.
+//
+// Solidity: event AuctionContractCreated(address indexed auctionContract, address indexed creator)
+func (_AuctionFactory *AuctionFactoryFilterer) ParseAuctionContractCreated(log types.Log) (*AuctionFactoryAuctionContractCreated, error) {
+	event := new(AuctionFactoryAuctionContractCreated)
+	if err := _AuctionFactory.contract.UnpackLog(event, "AuctionContractCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AuctionFactoryOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the AuctionFactory contract.
+type AuctionFactoryOwnershipTransferredIterator struct {
+	Event *AuctionFactoryOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AuctionFactoryOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AuctionFactoryOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AuctionFactoryOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AuctionFactoryOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AuctionFactoryOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AuctionFactoryOwnershipTransferred represents a OwnershipTransferred event raised by the AuctionFactory contract.
+type AuctionFactoryOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_AuctionFactory *AuctionFactoryFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*AuctionFactoryOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _AuctionFactory.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AuctionFactoryOwnershipTransferredIterator{contract: _AuctionFactory.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_AuctionFactory *AuctionFactoryFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *AuctionFactoryOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _AuctionFactory.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AuctionFactoryOwnershipTransferred)
+				if err := _AuctionFactory.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_AuctionFactory *AuctionFactoryFilterer) ParseOwnershipTransferred(log types.Log) (*AuctionFactoryOwnershipTransferred, error) {
+	event := new(AuctionFactoryOwnershipTransferred)
+	if err := _AuctionFactory.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
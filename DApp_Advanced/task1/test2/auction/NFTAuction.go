@@ -0,0 +1,1294 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package Auction
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// NFTAuctionMetaData contains all meta data concerning the NFTAuction contract.
+var NFTAuctionMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_ethUsdPriceFeed\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"ReentrancyGuardReentrantCall\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"auctionId\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"seller\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"nftContract\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"AuctionCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"auctionId\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"winner\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"AuctionEnded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"auctionId\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"bidder\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"BidPlaced\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"auctionId\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"bidder\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"BidWithdrawn\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"priceFeed\",\"type\":\"address\",\"indexed\":true}],\"name\":\"PriceFeedSet\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"auctions\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"seller\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nftContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"startTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"endTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"startingBid\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"highestBid\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"highestBidder\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"bidToken\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"ended\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"}],\"name\":\"convertToUsd\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"nftContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"startingBid\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"duration\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"bidToken\",\"type\":\"address\"}],\"name\":\"createAuction\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"auctionId\",\"type\":\"uint256\"}],\"name\":\"endAuction\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ethUsdPriceFeed\",\"outputs\":[{\"internalType\":\"contractAggregatorV3Interface\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"nextAuctionId\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"name\":\"onERC721Received\",\"outputs\":[{\"internalType\":\"bytes4\",\"name\":\"\",\"type\":\"bytes4\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"auctionId\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bidAmount\",\"type\":\"uint256\"}],\"name\":\"placeBid\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"priceFeed\",\"type\":\"address\"}],\"name\":\"setTokenPriceFeed\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"tokenUsdPriceFeeds\",\"outputs\":[{\"internalType\":\"contractAggregatorV3Interface\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+}
+
+// NFTAuctionABI is the input ABI used to generate the binding from.
+// Deprecated: Use NFTAuctionMetaData.ABI instead.
+var NFTAuctionABI = NFTAuctionMetaData.ABI
+
+// NFTAuction is an auto generated Go binding around an Ethereum contract.
+type NFTAuction struct {
+	NFTAuctionCaller     // Read-only binding to the contract
+	NFTAuctionTransactor // Write-only binding to the contract
+	NFTAuctionFilterer   // Log filterer for contract events
+}
+
+// NFTAuctionCaller is an auto generated read-only Go binding around an Ethereum contract.
+type NFTAuctionCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NFTAuctionTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type NFTAuctionTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NFTAuctionFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type NFTAuctionFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NFTAuctionSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type NFTAuctionSession struct {
+	Contract     *NFTAuction       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// NFTAuctionCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type NFTAuctionCallerSession struct {
+	Contract *NFTAuctionCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// NFTAuctionTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type NFTAuctionTransactorSession struct {
+	Contract     *NFTAuctionTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// NFTAuctionRaw is an auto generated low-level Go binding around an Ethereum contract.
+type NFTAuctionRaw struct {
+	Contract *NFTAuction // Generic contract binding to access the raw methods on
+}
+
+// NFTAuctionCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type NFTAuctionCallerRaw struct {
+	Contract *NFTAuctionCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// NFTAuctionTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type NFTAuctionTransactorRaw struct {
+	Contract *NFTAuctionTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewNFTAuction creates a new instance of NFTAuction, bound to a specific deployed contract.
+func NewNFTAuction(address common.Address, backend bind.ContractBackend) (*NFTAuction, error) {
+	contract, err := bindNFTAuction(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuction{NFTAuctionCaller: NFTAuctionCaller{contract: contract}, NFTAuctionTransactor: NFTAuctionTransactor{contract: contract}, NFTAuctionFilterer: NFTAuctionFilterer{contract: contract}}, nil
+}
+
+// NewNFTAuctionCaller creates a new read-only instance of NFTAuction, bound to a specific deployed contract.
+func NewNFTAuctionCaller(address common.Address, caller bind.ContractCaller) (*NFTAuctionCaller, error) {
+	contract, err := bindNFTAuction(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuctionCaller{contract: contract}, nil
+}
+
+// NewNFTAuctionTransactor creates a new write-only instance of NFTAuction, bound to a specific deployed contract.
+func NewNFTAuctionTransactor(address common.Address, transactor bind.ContractTransactor) (*NFTAuctionTransactor, error) {
+	contract, err := bindNFTAuction(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuctionTransactor{contract: contract}, nil
+}
+
+// NewNFTAuctionFilterer creates a new log filterer instance of NFTAuction, bound to a specific deployed contract.
+func NewNFTAuctionFilterer(address common.Address, filterer bind.ContractFilterer) (*NFTAuctionFilterer, error) {
+	contract, err := bindNFTAuction(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuctionFilterer{contract: contract}, nil
+}
+
+// bindNFTAuction binds a generic wrapper to an already deployed contract.
+func bindNFTAuction(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := NFTAuctionMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_NFTAuction *NFTAuctionRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _NFTAuction.Contract.NFTAuctionCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_NFTAuction *NFTAuctionRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NFTAuction.Contract.NFTAuctionTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_NFTAuction *NFTAuctionRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _NFTAuction.Contract.NFTAuctionTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_NFTAuction *NFTAuctionCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _NFTAuction.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_NFTAuction *NFTAuctionTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NFTAuction.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_NFTAuction *NFTAuctionTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _NFTAuction.Contract.contract.Transact(opts, method, params...)
+}
+
+// Auctions is a free data retrieval call binding the contract method 0x571a26a0.
+//
+// Solidity: function auctions(uint256 ) view returns(address seller, address nftContract, uint256 tokenId, uint256 startTime, uint256 endTime, uint256 startingBid, uint256 highestBid, address highestBidder, address bidToken, bool ended)
+func (_NFTAuction *NFTAuctionCaller) Auctions(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	Seller        common.Address
+	NftContract   common.Address
+	TokenId       *big.Int
+	StartTime     *big.Int
+	EndTime       *big.Int
+	StartingBid   *big.Int
+	HighestBid    *big.Int
+	HighestBidder common.Address
+	BidToken      common.Address
+	Ended         bool
+}, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "auctions", arg0)
+
+	outstruct := new(struct {
+		Seller        common.Address
+		NftContract   common.Address
+		TokenId       *big.Int
+		StartTime     *big.Int
+		EndTime       *big.Int
+		StartingBid   *big.Int
+		HighestBid    *big.Int
+		HighestBidder common.Address
+		BidToken      common.Address
+		Ended         bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Seller = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.NftContract = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.TokenId = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.StartTime = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.EndTime = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.StartingBid = *abi.ConvertType(out[5], new(*big.Int)).(**big.Int)
+	outstruct.HighestBid = *abi.ConvertType(out[6], new(*big.Int)).(**big.Int)
+	outstruct.HighestBidder = *abi.ConvertType(out[7], new(common.Address)).(*common.Address)
+	outstruct.BidToken = *abi.ConvertType(out[8], new(common.Address)).(*common.Address)
+	outstruct.Ended = *abi.ConvertType(out[9], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// Auctions is a free data retrieval call binding the contract method 0x571a26a0.
+//
+// Solidity: function auctions(uint256 ) view returns(address seller, address nftContract, uint256 tokenId, uint256 startTime, uint256 endTime, uint256 startingBid, uint256 highestBid, address highestBidder, address bidToken, bool ended)
+func (_NFTAuction *NFTAuctionSession) Auctions(arg0 *big.Int) (struct {
+	Seller        common.Address
+	NftContract   common.Address
+	TokenId       *big.Int
+	StartTime     *big.Int
+	EndTime       *big.Int
+	StartingBid   *big.Int
+	HighestBid    *big.Int
+	HighestBidder common.Address
+	BidToken      common.Address
+	Ended         bool
+}, error) {
+	return _NFTAuction.Contract.Auctions(&_NFTAuction.CallOpts, arg0)
+}
+
+// Auctions is a free data retrieval call binding the contract method 0x571a26a0.
+//
+// Solidity: function auctions(uint256 ) view returns(address seller, address nftContract, uint256 tokenId, uint256 startTime, uint256 endTime, uint256 startingBid, uint256 highestBid, address highestBidder, address bidToken, bool ended)
+func (_NFTAuction *NFTAuctionCallerSession) Auctions(arg0 *big.Int) (struct {
+	Seller        common.Address
+	NftContract   common.Address
+	TokenId       *big.Int
+	StartTime     *big.Int
+	EndTime       *big.Int
+	StartingBid   *big.Int
+	HighestBid    *big.Int
+	HighestBidder common.Address
+	BidToken      common.Address
+	Ended         bool
+}, error) {
+	return _NFTAuction.Contract.Auctions(&_NFTAuction.CallOpts, arg0)
+}
+
+// ConvertToUsd is a free data retrieval call binding the contract method 0x82544de5.
+//
+// Solidity: function convertToUsd(uint256 amount, address token) view returns(uint256)
+func (_NFTAuction *NFTAuctionCaller) ConvertToUsd(opts *bind.CallOpts, amount *big.Int, token common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "convertToUsd", amount, token)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ConvertToUsd is a free data retrieval call binding the contract method 0x82544de5.
+//
+// Solidity: function convertToUsd(uint256 amount, address token) view returns(uint256)
+func (_NFTAuction *NFTAuctionSession) ConvertToUsd(amount *big.Int, token common.Address) (*big.Int, error) {
+	return _NFTAuction.Contract.ConvertToUsd(&_NFTAuction.CallOpts, amount, token)
+}
+
+// ConvertToUsd is a free data retrieval call binding the contract method 0x82544de5.
+//
+// Solidity: function convertToUsd(uint256 amount, address token) view returns(uint256)
+func (_NFTAuction *NFTAuctionCallerSession) ConvertToUsd(amount *big.Int, token common.Address) (*big.Int, error) {
+	return _NFTAuction.Contract.ConvertToUsd(&_NFTAuction.CallOpts, amount, token)
+}
+
+// EthUsdPriceFeed is a free data retrieval call binding the contract method 0x42f6fb29.
+//
+// Solidity: function ethUsdPriceFeed() view returns(address)
+func (_NFTAuction *NFTAuctionCaller) EthUsdPriceFeed(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "ethUsdPriceFeed")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// EthUsdPriceFeed is a free data retrieval call binding the contract method 0x42f6fb29.
+//
+// Solidity: function ethUsdPriceFeed() view returns(address)
+func (_NFTAuction *NFTAuctionSession) EthUsdPriceFeed() (common.Address, error) {
+	return _NFTAuction.Contract.EthUsdPriceFeed(&_NFTAuction.CallOpts)
+}
+
+// EthUsdPriceFeed is a free data retrieval call binding the contract method 0x42f6fb29.
+//
+// Solidity: function ethUsdPriceFeed() view returns(address)
+func (_NFTAuction *NFTAuctionCallerSession) EthUsdPriceFeed() (common.Address, error) {
+	return _NFTAuction.Contract.EthUsdPriceFeed(&_NFTAuction.CallOpts)
+}
+
+// NextAuctionId is a free data retrieval call binding the contract method 0xfc528482.
+//
+// Solidity: function nextAuctionId() view returns(uint256)
+func (_NFTAuction *NFTAuctionCaller) NextAuctionId(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "nextAuctionId")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NextAuctionId is a free data retrieval call binding the contract method 0xfc528482.
+//
+// Solidity: function nextAuctionId() view returns(uint256)
+func (_NFTAuction *NFTAuctionSession) NextAuctionId() (*big.Int, error) {
+	return _NFTAuction.Contract.NextAuctionId(&_NFTAuction.CallOpts)
+}
+
+// NextAuctionId is a free data retrieval call binding the contract method 0xfc528482.
+//
+// Solidity: function nextAuctionId() view returns(uint256)
+func (_NFTAuction *NFTAuctionCallerSession) NextAuctionId() (*big.Int, error) {
+	return _NFTAuction.Contract.NextAuctionId(&_NFTAuction.CallOpts)
+}
+
+// TokenUsdPriceFeeds is a free data retrieval call binding the contract method 0x628a9c96.
+//
+// Solidity: function tokenUsdPriceFeeds(address ) view returns(address)
+func (_NFTAuction *NFTAuctionCaller) TokenUsdPriceFeeds(opts *bind.CallOpts, arg0 common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _NFTAuction.contract.Call(opts, &out, "tokenUsdPriceFeeds", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// TokenUsdPriceFeeds is a free data retrieval call binding the contract method 0x628a9c96.
+//
+// Solidity: function tokenUsdPriceFeeds(address ) view returns(address)
+func (_NFTAuction *NFTAuctionSession) TokenUsdPriceFeeds(arg0 common.Address) (common.Address, error) {
+	return _NFTAuction.Contract.TokenUsdPriceFeeds(&_NFTAuction.CallOpts, arg0)
+}
+
+// TokenUsdPriceFeeds is a free data retrieval call binding the contract method 0x628a9c96.
+//
+// Solidity: function tokenUsdPriceFeeds(address ) view returns(address)
+func (_NFTAuction *NFTAuctionCallerSession) TokenUsdPriceFeeds(arg0 common.Address) (common.Address, error) {
+	return _NFTAuction.Contract.TokenUsdPriceFeeds(&_NFTAuction.CallOpts, arg0)
+}
+
+// CreateAuction is a paid mutator transaction binding the contract method 0xb0b211da.
+//
+// Solidity: function createAuction(address nftContract, uint256 tokenId, uint256 startingBid, uint256 duration, address bidToken) returns()
+func (_NFTAuction *NFTAuctionTransactor) CreateAuction(opts *bind.TransactOpts, nftContract common.Address, tokenId *big.Int, startingBid *big.Int, duration *big.Int, bidToken common.Address) (*types.Transaction, error) {
+	return _NFTAuction.contract.Transact(opts, "createAuction", nftContract, tokenId, startingBid, duration, bidToken)
+}
+
+// CreateAuction is a paid mutator transaction binding the contract method 0xb0b211da.
+//
+// Solidity: function createAuction(address nftContract, uint256 tokenId, uint256 startingBid, uint256 duration, address bidToken) returns()
+func (_NFTAuction *NFTAuctionSession) CreateAuction(nftContract common.Address, tokenId *big.Int, startingBid *big.Int, duration *big.Int, bidToken common.Address) (*types.Transaction, error) {
+	return _NFTAuction.Contract.CreateAuction(&_NFTAuction.TransactOpts, nftContract, tokenId, startingBid, duration, bidToken)
+}
+
+// CreateAuction is a paid mutator transaction binding the contract method 0xb0b211da.
+//
+// Solidity: function createAuction(address nftContract, uint256 tokenId, uint256 startingBid, uint256 duration, address bidToken) returns()
+func (_NFTAuction *NFTAuctionTransactorSession) CreateAuction(nftContract common.Address, tokenId *big.Int, startingBid *big.Int, duration *big.Int, bidToken common.Address) (*types.Transaction, error) {
+	return _NFTAuction.Contract.CreateAuction(&_NFTAuction.TransactOpts, nftContract, tokenId, startingBid, duration, bidToken)
+}
+
+// EndAuction is a paid mutator transaction binding the contract method 0xb9a2de3a.
+//
+// Solidity: function endAuction(uint256 auctionId) returns()
+func (_NFTAuction *NFTAuctionTransactor) EndAuction(opts *bind.TransactOpts, auctionId *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.contract.Transact(opts, "endAuction", auctionId)
+}
+
+// EndAuction is a paid mutator transaction binding the contract method 0xb9a2de3a.
+//
+// Solidity: function endAuction(uint256 auctionId) returns()
+func (_NFTAuction *NFTAuctionSession) EndAuction(auctionId *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.EndAuction(&_NFTAuction.TransactOpts, auctionId)
+}
+
+// EndAuction is a paid mutator transaction binding the contract method 0xb9a2de3a.
+//
+// Solidity: function endAuction(uint256 auctionId) returns()
+func (_NFTAuction *NFTAuctionTransactorSession) EndAuction(auctionId *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.EndAuction(&_NFTAuction.TransactOpts, auctionId)
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address , uint256 , bytes ) returns(bytes4)
+func (_NFTAuction *NFTAuctionTransactor) OnERC721Received(opts *bind.TransactOpts, arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 []byte) (*types.Transaction, error) {
+	return _NFTAuction.contract.Transact(opts, "onERC721Received", arg0, arg1, arg2, arg3)
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address , uint256 , bytes ) returns(bytes4)
+func (_NFTAuction *NFTAuctionSession) OnERC721Received(arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 []byte) (*types.Transaction, error) {
+	return _NFTAuction.Contract.OnERC721Received(&_NFTAuction.TransactOpts, arg0, arg1, arg2, arg3)
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address , uint256 , bytes ) returns(bytes4)
+func (_NFTAuction *NFTAuctionTransactorSession) OnERC721Received(arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 []byte) (*types.Transaction, error) {
+	return _NFTAuction.Contract.OnERC721Received(&_NFTAuction.TransactOpts, arg0, arg1, arg2, arg3)
+}
+
+// PlaceBid is a paid mutator transaction binding the contract method 0x57c90de5.
+//
+// Solidity: function placeBid(uint256 auctionId, uint256 bidAmount) payable returns()
+func (_NFTAuction *NFTAuctionTransactor) PlaceBid(opts *bind.TransactOpts, auctionId *big.Int, bidAmount *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.contract.Transact(opts, "placeBid", auctionId, bidAmount)
+}
+
+// PlaceBid is a paid mutator transaction binding the contract method 0x57c90de5.
+//
+// Solidity: function placeBid(uint256 auctionId, uint256 bidAmount) payable returns()
+func (_NFTAuction *NFTAuctionSession) PlaceBid(auctionId *big.Int, bidAmount *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.PlaceBid(&_NFTAuction.TransactOpts, auctionId, bidAmount)
+}
+
+// PlaceBid is a paid mutator transaction binding the contract method 0x57c90de5.
+//
+// Solidity: function placeBid(uint256 auctionId, uint256 bidAmount) payable returns()
+func (_NFTAuction *NFTAuctionTransactorSession) PlaceBid(auctionId *big.Int, bidAmount *big.Int) (*types.Transaction, error) {
+	return _NFTAuction.Contract.PlaceBid(&_NFTAuction.TransactOpts, auctionId, bidAmount)
+}
+
+// SetTokenPriceFeed is a paid mutator transaction binding the contract method 0x674417ae.
+//
+// Solidity: function setTokenPriceFeed(address token, address priceFeed) returns()
+func (_NFTAuction *NFTAuctionTransactor) SetTokenPriceFeed(opts *bind.TransactOpts, token common.Address, priceFeed common.Address) (*types.Transaction, error) {
+	return _NFTAuction.contract.Transact(opts, "setTokenPriceFeed", token, priceFeed)
+}
+
+// SetTokenPriceFeed is a paid mutator transaction binding the contract method 0x674417ae.
+//
+// Solidity: function setTokenPriceFeed(address token, address priceFeed) returns()
+func (_NFTAuction *NFTAuctionSession) SetTokenPriceFeed(token common.Address, priceFeed common.Address) (*types.Transaction, error) {
+	return _NFTAuction.Contract.SetTokenPriceFeed(&_NFTAuction.TransactOpts, token, priceFeed)
+}
+
+// SetTokenPriceFeed is a paid mutator transaction binding the contract method 0x674417ae.
+//
+// Solidity: function setTokenPriceFeed(address token, address priceFeed) returns()
+func (_NFTAuction *NFTAuctionTransactorSession) SetTokenPriceFeed(token common.Address, priceFeed common.Address) (*types.Transaction, error) {
+	return _NFTAuction.Contract.SetTokenPriceFeed(&_NFTAuction.TransactOpts, token, priceFeed)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_NFTAuction *NFTAuctionTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NFTAuction.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_NFTAuction *NFTAuctionSession) Receive() (*types.Transaction, error) {
+	return _NFTAuction.Contract.Receive(&_NFTAuction.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_NFTAuction *NFTAuctionTransactorSession) Receive() (*types.Transaction, error) {
+	return _NFTAuction.Contract.Receive(&_NFTAuction.TransactOpts)
+}
+
+// NFTAuctionAuctionCreatedIterator is returned from FilterAuctionCreated and is used to iterate over the raw logs and unpacked data for AuctionCreated events raised by the NFTAuction contract.
+type NFTAuctionAuctionCreatedIterator struct {
+	Event *NFTAuctionAuctionCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NFTAuctionAuctionCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NFTAuctionAuctionCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NFTAuctionAuctionCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NFTAuctionAuctionCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NFTAuctionAuctionCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NFTAuctionAuctionCreated represents a AuctionCreated event raised by the NFTAuction contract.
+type NFTAuctionAuctionCreated struct {
+	AuctionId   *big.Int
+	Seller      common.Address
+	NftContract common.Address
+	TokenId     *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterAuctionCreated is a free log retrieval operation binding the contract event 0x8777bed2a899ba8843de663a1f6ed1c48d071cc8bde08e2488b59c00c1993f76.
+//
+// Solidity: event AuctionCreated(uint256 indexed auctionId, address indexed seller, address indexed nftContract, uint256 tokenId)
+func (_NFTAuction *NFTAuctionFilterer) FilterAuctionCreated(opts *bind.FilterOpts, auctionId []*big.Int, seller []common.Address, nftContract []common.Address) (*NFTAuctionAuctionCreatedIterator, error) {
+
+	var auctionIdRule []interface{}
+	for _, auctionIdItem := range auctionId {
+		auctionIdRule = append(auctionIdRule, auctionIdItem)
+	}
+	var sellerRule []interface{}
+	for _, sellerItem := range seller {
+		sellerRule = append(sellerRule, sellerItem)
+	}
+	var nftContractRule []interface{}
+	for _, nftContractItem := range nftContract {
+		nftContractRule = append(nftContractRule, nftContractItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.FilterLogs(opts, "AuctionCreated", auctionIdRule, sellerRule, nftContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuctionAuctionCreatedIterator{contract: _NFTAuction.contract, event: "AuctionCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchAuctionCreated is a free log subscription operation binding the contract event 0x8777bed2a899ba8843de663a1f6ed1c48d071cc8bde08e2488b59c00c1993f76.
+//
+// Solidity: event AuctionCreated(uint256 indexed auctionId, address indexed seller, address indexed nftContract, uint256 tokenId)
+func (_NFTAuction *NFTAuctionFilterer) WatchAuctionCreated(opts *bind.WatchOpts, sink chan<- *NFTAuctionAuctionCreated, auctionId []*big.Int, seller []common.Address, nftContract []common.Address) (event.Subscription, error) {
+
+	var auctionIdRule []interface{}
+	for _, auctionIdItem := range auctionId {
+		auctionIdRule = append(auctionIdRule, auctionIdItem)
+	}
+	var sellerRule []interface{}
+	for _, sellerItem := range seller {
+		sellerRule = append(sellerRule, sellerItem)
+	}
+	var nftContractRule []interface{}
+	for _, nftContractItem := range nftContract {
+		nftContractRule = append(nftContractRule, nftContractItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.WatchLogs(opts, "AuctionCreated", auctionIdRule, sellerRule, nftContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NFTAuctionAuctionCreated)
+				if err := _NFTAuction.contract.UnpackLog(event, "AuctionCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAuctionCreated is a log parse operation binding the contract event 0x8777bed2a899ba8843de663a1f6ed1c48d071cc8bde08e2488b59c00c1993f76.
+//
+// Solidity: event AuctionCreated(uint256 indexed auctionId, address indexed seller, address indexed nftContract, uint256 tokenId)
+func (_NFTAuction *NFTAuctionFilterer) ParseAuctionCreated(log types.Log) (*NFTAuctionAuctionCreated, error) {
+	event := new(NFTAuctionAuctionCreated)
+	if err := _NFTAuction.contract.UnpackLog(event, "AuctionCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NFTAuctionAuctionEndedIterator is returned from FilterAuctionEnded and is used to iterate over the raw logs and unpacked data for AuctionEnded events raised by the NFTAuction contract.
+type NFTAuctionAuctionEndedIterator struct {
+	Event *NFTAuctionAuctionEnded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NFTAuctionAuctionEndedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NFTAuctionAuctionEnded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NFTAuctionAuctionEnded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NFTAuctionAuctionEndedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NFTAuctionAuctionEndedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NFTAuctionAuctionEnded represents a AuctionEnded event raised by the NFTAuction contract.
+type NFTAuctionAuctionEnded struct {
+	AuctionId *big.Int
+	Winner    common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterAuctionEnded is a free log retrieval operation binding the contract event 0xd2aa34a4fdbbc6dff6a3e56f46e0f3ae2a31d7785ff3487aa5c95c642acea501.
+//
+// Solidity: event AuctionEnded(uint256 indexed auctionId, address indexed winner, uint256 amount)
+func (_NFTAuction *NFTAuctionFilterer) FilterAuctionEnded(opts *bind.FilterOpts, auctionId []*big.Int, winner []common.Address) (*NFTAuctionAuctionEndedIterator, error) {
+
+	var auctionIdRule []interface{}
+	for _, auctionIdItem := range auctionId {
+		auctionIdRule = append(auctionIdRule, auctionIdItem)
+	}
+	var winnerRule []interface{}
+	for _, winnerItem := range winner {
+		winnerRule = append(winnerRule, winnerItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.FilterLogs(opts, "AuctionEnded", auctionIdRule, winnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuctionAuctionEndedIterator{contract: _NFTAuction.contract, event: "AuctionEnded", logs: logs, sub: sub}, nil
+}
+
+// WatchAuctionEnded is a free log subscription operation binding the contract event 0xd2aa34a4fdbbc6dff6a3e56f46e0f3ae2a31d7785ff3487aa5c95c642acea501.
+//
+// Solidity: event AuctionEnded(uint256 indexed auctionId, address indexed winner, uint256 amount)
+func (_NFTAuction *NFTAuctionFilterer) WatchAuctionEnded(opts *bind.WatchOpts, sink chan<- *NFTAuctionAuctionEnded, auctionId []*big.Int, winner []common.Address) (event.Subscription, error) {
+
+	var auctionIdRule []interface{}
+	for _, auctionIdItem := range auctionId {
+		auctionIdRule = append(auctionIdRule, auctionIdItem)
+	}
+	var winnerRule []interface{}
+	for _, winnerItem := range winner {
+		winnerRule = append(winnerRule, winnerItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.WatchLogs(opts, "AuctionEnded", auctionIdRule, winnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NFTAuctionAuctionEnded)
+				if err := _NFTAuction.contract.UnpackLog(event, "AuctionEnded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAuctionEnded is a log parse operation binding the contract event 0xd2aa34a4fdbbc6dff6a3e56f46e0f3ae2a31d7785ff3487aa5c95c642acea501.
+//
+// Solidity: event AuctionEnded(uint256 indexed auctionId, address indexed winner, uint256 amount)
+func (_NFTAuction *NFTAuctionFilterer) ParseAuctionEnded(log types.Log) (*NFTAuctionAuctionEnded, error) {
+	event := new(NFTAuctionAuctionEnded)
+	if err := _NFTAuction.contract.UnpackLog(event, "AuctionEnded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NFTAuctionBidPlacedIterator is returned from FilterBidPlaced and is used to iterate over the raw logs and unpacked data for BidPlaced events raised by the NFTAuction contract.
+type NFTAuctionBidPlacedIterator struct {
+	Event *NFTAuctionBidPlaced // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NFTAuctionBidPlacedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NFTAuctionBidPlaced)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NFTAuctionBidPlaced)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NFTAuctionBidPlacedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NFTAuctionBidPlacedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NFTAuctionBidPlaced represents a BidPlaced event raised by the NFTAuction contract.
+type NFTAuctionBidPlaced struct {
+	AuctionId *big.Int
+	Bidder    common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterBidPlaced is a free log retrieval operation binding the contract event 0x0e54eff26401bf69b81b26f60bd85ef47f5d85275c1d268d84f68d6897431c47.
+//
+// Solidity: event BidPlaced(uint256 indexed auctionId, address indexed bidder, uint256 amount)
+func (_NFTAuction *NFTAuctionFilterer) FilterBidPlaced(opts *bind.FilterOpts, auctionId []*big.Int, bidder []common.Address) (*NFTAuctionBidPlacedIterator, error) {
+
+	var auctionIdRule []interface{}
+	for _, auctionIdItem := range auctionId {
+		auctionIdRule = append(auctionIdRule, auctionIdItem)
+	}
+	var bidderRule []interface{}
+	for _, bidderItem := range bidder {
+		bidderRule = append(bidderRule, bidderItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.FilterLogs(opts, "BidPlaced", auctionIdRule, bidderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuctionBidPlacedIterator{contract: _NFTAuction.contract, event: "BidPlaced", logs: logs, sub: sub}, nil
+}
+
+// WatchBidPlaced is a free log subscription operation binding the contract event 0x0e54eff26401bf69b81b26f60bd85ef47f5d85275c1d268d84f68d6897431c47.
+//
+// Solidity: event BidPlaced(uint256 indexed auctionId, address indexed bidder, uint256 amount)
+func (_NFTAuction *NFTAuctionFilterer) WatchBidPlaced(opts *bind.WatchOpts, sink chan<- *NFTAuctionBidPlaced, auctionId []*big.Int, bidder []common.Address) (event.Subscription, error) {
+
+	var auctionIdRule []interface{}
+	for _, auctionIdItem := range auctionId {
+		auctionIdRule = append(auctionIdRule, auctionIdItem)
+	}
+	var bidderRule []interface{}
+	for _, bidderItem := range bidder {
+		bidderRule = append(bidderRule, bidderItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.WatchLogs(opts, "BidPlaced", auctionIdRule, bidderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NFTAuctionBidPlaced)
+				if err := _NFTAuction.contract.UnpackLog(event, "BidPlaced", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBidPlaced is a log parse operation binding the contract event 0x0e54eff26401bf69b81b26f60bd85ef47f5d85275c1d268d84f68d6897431c47.
+//
+// Solidity: event BidPlaced(uint256 indexed auctionId, address indexed bidder, uint256 amount)
+func (_NFTAuction *NFTAuctionFilterer) ParseBidPlaced(log types.Log) (*NFTAuctionBidPlaced, error) {
+	event := new(NFTAuctionBidPlaced)
+	if err := _NFTAuction.contract.UnpackLog(event, "BidPlaced", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NFTAuctionBidWithdrawnIterator is returned from FilterBidWithdrawn and is used to iterate over the raw logs and unpacked data for BidWithdrawn events raised by the NFTAuction contract.
+type NFTAuctionBidWithdrawnIterator struct {
+	Event *NFTAuctionBidWithdrawn // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NFTAuctionBidWithdrawnIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NFTAuctionBidWithdrawn)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NFTAuctionBidWithdrawn)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NFTAuctionBidWithdrawnIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NFTAuctionBidWithdrawnIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NFTAuctionBidWithdrawn represents a BidWithdrawn event raised by the NFTAuction contract.
+type NFTAuctionBidWithdrawn struct {
+	AuctionId *big.Int
+	Bidder    common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterBidWithdrawn is a free log retrieval operation binding the contract event 0x8f8619524e8d462cead34604bd2247ede24175801481e4d0b8059ac8aa41c301.
+//
+// Solidity: event BidWithdrawn(uint256 indexed auctionId, address indexed bidder, uint256 amount)
+func (_NFTAuction *NFTAuctionFilterer) FilterBidWithdrawn(opts *bind.FilterOpts, auctionId []*big.Int, bidder []common.Address) (*NFTAuctionBidWithdrawnIterator, error) {
+
+	var auctionIdRule []interface{}
+	for _, auctionIdItem := range auctionId {
+		auctionIdRule = append(auctionIdRule, auctionIdItem)
+	}
+	var bidderRule []interface{}
+	for _, bidderItem := range bidder {
+		bidderRule = append(bidderRule, bidderItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.FilterLogs(opts, "BidWithdrawn", auctionIdRule, bidderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuctionBidWithdrawnIterator{contract: _NFTAuction.contract, event: "BidWithdrawn", logs: logs, sub: sub}, nil
+}
+
+// WatchBidWithdrawn is a free log subscription operation binding the contract event 0x8f8619524e8d462cead34604bd2247ede24175801481e4d0b8059ac8aa41c301.
+//
+// Solidity: event BidWithdrawn(uint256 indexed auctionId, address indexed bidder, uint256 amount)
+func (_NFTAuction *NFTAuctionFilterer) WatchBidWithdrawn(opts *bind.WatchOpts, sink chan<- *NFTAuctionBidWithdrawn, auctionId []*big.Int, bidder []common.Address) (event.Subscription, error) {
+
+	var auctionIdRule []interface{}
+	for _, auctionIdItem := range auctionId {
+		auctionIdRule = append(auctionIdRule, auctionIdItem)
+	}
+	var bidderRule []interface{}
+	for _, bidderItem := range bidder {
+		bidderRule = append(bidderRule, bidderItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.WatchLogs(opts, "BidWithdrawn", auctionIdRule, bidderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NFTAuctionBidWithdrawn)
+				if err := _NFTAuction.contract.UnpackLog(event, "BidWithdrawn", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBidWithdrawn is a log parse operation binding the contract event 0x8f8619524e8d462cead34604bd2247ede24175801481e4d0b8059ac8aa41c301.
+//
+// Solidity: event BidWithdrawn(uint256 indexed auctionId, address indexed bidder, uint256 amount)
+func (_NFTAuction *NFTAuctionFilterer) ParseBidWithdrawn(log types.Log) (*NFTAuctionBidWithdrawn, error) {
+	event := new(NFTAuctionBidWithdrawn)
+	if err := _NFTAuction.contract.UnpackLog(event, "BidWithdrawn", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NFTAuctionPriceFeedSetIterator is returned from FilterPriceFeedSet and is used to iterate over the raw logs and unpacked data for PriceFeedSet events raised by the NFTAuction contract.
+type NFTAuctionPriceFeedSetIterator struct {
+	Event *NFTAuctionPriceFeedSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NFTAuctionPriceFeedSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NFTAuctionPriceFeedSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NFTAuctionPriceFeedSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NFTAuctionPriceFeedSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NFTAuctionPriceFeedSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NFTAuctionPriceFeedSet represents a PriceFeedSet event raised by the NFTAuction contract.
+type NFTAuctionPriceFeedSet struct {
+	Token     common.Address
+	PriceFeed common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterPriceFeedSet is a free log retrieval operation binding the contract event 0xd2d8394cf7549a5ddbc2ba3dd7b2de8d53c891472d1f2907008ed6a10045fdae.
+//
+// Solidity: event PriceFeedSet(address indexed token, address indexed priceFeed)
+func (_NFTAuction *NFTAuctionFilterer) FilterPriceFeedSet(opts *bind.FilterOpts, token []common.Address, priceFeed []common.Address) (*NFTAuctionPriceFeedSetIterator, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+	var priceFeedRule []interface{}
+	for _, priceFeedItem := range priceFeed {
+		priceFeedRule = append(priceFeedRule, priceFeedItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.FilterLogs(opts, "PriceFeedSet", tokenRule, priceFeedRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NFTAuctionPriceFeedSetIterator{contract: _NFTAuction.contract, event: "PriceFeedSet", logs: logs, sub: sub}, nil
+}
+
+// WatchPriceFeedSet is a free log subscription operation binding the contract event 0xd2d8394cf7549a5ddbc2ba3dd7b2de8d53c891472d1f2907008ed6a10045fdae.
+//
+// Solidity: event PriceFeedSet(address indexed token, address indexed priceFeed)
+func (_NFTAuction *NFTAuctionFilterer) WatchPriceFeedSet(opts *bind.WatchOpts, sink chan<- *NFTAuctionPriceFeedSet, token []common.Address, priceFeed []common.Address) (event.Subscription, error) {
+
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+	var priceFeedRule []interface{}
+	for _, priceFeedItem := range priceFeed {
+		priceFeedRule = append(priceFeedRule, priceFeedItem)
+	}
+
+	logs, sub, err := _NFTAuction.contract.WatchLogs(opts, "PriceFeedSet", tokenRule, priceFeedRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NFTAuctionPriceFeedSet)
+				if err := _NFTAuction.contract.UnpackLog(event, "PriceFeedSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePriceFeedSet is a log parse operation binding the contract event 0xd2d8394cf7549a5ddbc2ba3dd7b2de8d53c891472d1f2907008ed6a10045fdae.
+//
+// Solidity: event PriceFeedSet(address indexed token, address indexed priceFeed)
+func (_NFTAuction *NFTAuctionFilterer) ParsePriceFeedSet(log types.Log) (*NFTAuctionPriceFeedSet, error) {
+	event := new(NFTAuctionPriceFeedSet)
+	if err := _NFTAuction.contract.UnpackLog(event, "PriceFeedSet", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,586 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	Auction "counter/auction" // 别名导入，使用首字母大写的包名
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// auctionEventNames 拍卖索引器监听的事件：工厂合约的 AuctionContractCreated 和拍卖合约的拍卖、出价事件
+var auctionEventNames = []string{"AuctionContractCreated", "AuctionCreated", "BidPlaced", "BidWithdrawn", "AuctionEnded"}
+
+// AuctionStatus 拍卖的结果
+type AuctionStatus string
+
+const (
+	AuctionOpen        AuctionStatus = "open"         // 拍卖进行中，或尚未读取到结束时间
+	AuctionAwaitingEnd AuctionStatus = "awaiting_end" // 已过结束时间且有出价，等待调用 endAuction 或 AuctionEnded 事件确认
+	AuctionSold        AuctionStatus = "sold"         // 已成交（AuctionEnded 事件）
+	AuctionUnsold      AuctionStatus = "unsold"       // 已过结束时间且没有出价，NFT已退回卖家或等待调用 endAuction 退回
+)
+
+// AuctionBid 一次出价
+type AuctionBid struct {
+	Bidder   common.Address
+	Amount   *big.Int
+	Refunded bool // 被更高的出价超过，资金已退回（BidWithdrawn 事件）
+	Block    uint64
+	TxHash   common.Hash
+	LogIndex uint
+}
+
+// AuctionDetails 从拍卖合约 auctions(id) 读取的信息，事件中没有这些字段
+type AuctionDetails struct {
+	StartingBid *big.Int
+	EndTime     time.Time
+	BidToken    common.Address // 零地址表示使用ETH出价
+	Ended       bool           // 已调用 endAuction
+}
+
+// AuctionSummary 一场拍卖的索引结果
+type AuctionSummary struct {
+	Contract      common.Address // 拍卖合约地址（由工厂合约创建）
+	ID            *big.Int       // 拍卖合约内的拍卖ID
+	Seller        common.Address
+	NFTContract   common.Address
+	TokenID       *big.Int
+	CreatedBlock  uint64         // 0 表示还没有处理到 AuctionCreated 事件
+	HighestBid    *big.Int       // 没有出价时为 nil
+	HighestBidder common.Address // 没有出价时为零地址
+	Bids          []AuctionBid   // 按区块和日志顺序排列的出价历史
+	Winner        common.Address // 成交时的买家
+	FinalPrice    *big.Int       // 成交价，未成交时为 nil
+	Details       *AuctionDetails
+	Status        AuctionStatus
+}
+
+// auctionKey 拍卖在索引中的键，拍卖ID只在所属的拍卖合约内唯一
+type auctionKey struct {
+	contract common.Address
+	id       string
+}
+
+func newAuctionKey(contract common.Address, id *big.Int) auctionKey {
+	return auctionKey{contract: contract, id: id.String()}
+}
+
+// auctionState 一场拍卖收到的事件
+// 不同区块的事件由不同的工作协程并发处理，处理顺序与链上顺序不一定一致，
+// 因此只记录事件本身，最高出价和结果在生成摘要时按链上顺序计算
+type auctionState struct {
+	contract common.Address
+	id       *big.Int
+	created  *Auction.NFTAuctionAuctionCreated
+	bids     []*Auction.NFTAuctionBidPlaced
+	refunds  []*Auction.NFTAuctionBidWithdrawn
+	ended    *Auction.NFTAuctionAuctionEnded
+	details  *AuctionDetails
+}
+
+// AuctionIndexer 基于 EventHandler 的NFT拍卖索引器
+// 跟踪工厂合约通过 AuctionContractCreated 创建的每个拍卖合约，记录其中每场拍卖的最高出价、出价历史和结果。
+// 事件的确认、重试、重组回滚和检查点都由 EventHandler 负责，重启时从事件存储中已处理的事件重建索引
+type AuctionIndexer struct {
+	factory  common.Address
+	factoryP *Auction.AuctionFactoryFilterer // 解析工厂合约事件
+	auctionP *Auction.NFTAuctionFilterer     // 解析拍卖合约事件，解析日志时不检查合约地址
+	events   map[common.Hash]string          // 拍卖合约事件的 topic0 到事件名，创建时计算一次
+
+	mu        sync.RWMutex
+	contracts map[common.Address]*Auction.AuctionFactoryAuctionContractCreated // 工厂合约创建的拍卖合约
+	auctions  map[auctionKey]*auctionState
+}
+
+// NewAuctionIndexer 创建跟踪 factory 工厂合约的拍卖索引器
+func NewAuctionIndexer(factory common.Address) (*AuctionIndexer, error) {
+	factoryP, err := Auction.NewAuctionFactoryFilterer(factory, nil)
+	if err != nil {
+		return nil, err
+	}
+	auctionP, err := Auction.NewNFTAuctionFilterer(common.Address{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := Auction.NFTAuctionMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	events := make(map[common.Hash]string)
+	for _, name := range auctionEventNames[1:] { // 第一个是工厂合约的事件
+		event, ok := parsed.Events[name]
+		if !ok {
+			return nil, fmt.Errorf("event %s not found in NFTAuction ABI", name)
+		}
+		events[event.ID] = name
+	}
+	return &AuctionIndexer{
+		factory:   factory,
+		factoryP:  factoryP,
+		auctionP:  auctionP,
+		events:    events,
+		contracts: make(map[common.Address]*Auction.AuctionFactoryAuctionContractCreated),
+		auctions:  make(map[auctionKey]*auctionState),
+	}, nil
+}
+
+// auctionEventsABI 合并工厂合约和拍卖合约ABI中的事件，供同一个事件处理器解码两种合约的日志，
+// 两种合约的事件共用一个区块检查点，拍卖合约的事件不会早于创建它的 AuctionContractCreated 被扫描
+func auctionEventsABI() (string, error) {
+	var events []json.RawMessage
+	for _, abiJSON := range []string{Auction.AuctionFactoryMetaData.ABI, Auction.NFTAuctionMetaData.ABI} {
+		var entries []json.RawMessage
+		if err := json.Unmarshal([]byte(abiJSON), &entries); err != nil {
+			return "", err
+		}
+		for _, entry := range entries {
+			var header struct {
+				Type string `json:"type"`
+			}
+			if err := json.Unmarshal(entry, &header); err != nil {
+				return "", err
+			}
+			if header.Type == "event" {
+				events = append(events, entry)
+			}
+		}
+	}
+	merged, err := json.Marshal(events)
+	return string(merged), err
+}
+
+// Restore 从事件存储中已处理（且未被重组移除）的事件重建索引，需要在创建事件处理器之前调用，
+// 之后用 Addresses 作为事件处理器的监听地址。返回恢复的事件数
+func (ix *AuctionIndexer) Restore(store EventStore) (int, error) {
+	records, err := store.ListByStatus(EventStatusProcessed)
+	if err != nil {
+		return 0, err
+	}
+
+	restored := 0
+	for _, record := range records {
+		if record.Confirm == ConfirmRemoved || record.Log == nil {
+			continue
+		}
+		if _, err := ix.apply(*record.Log); err != nil {
+			return restored, fmt.Errorf("restore %s: %w", record.Key(), err)
+		}
+		restored++
+	}
+	return restored, nil
+}
+
+// Addresses 返回需要监听的合约地址：工厂合约和已知的拍卖合约
+func (ix *AuctionIndexer) Addresses() []common.Address {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	addresses := []common.Address{ix.factory}
+	for contract := range ix.contracts {
+		addresses = append(addresses, contract)
+	}
+	sort.Slice(addresses[1:], func(i, j int) bool {
+		return addresses[1+i].Cmp(addresses[1+j]) < 0
+	})
+	return addresses
+}
+
+// Register 在事件处理器上注册拍卖事件的处理和回滚回调，需要在 Start 之前调用
+// 处理器需要使用 auctionEventsABI 创建并监听 auctionEventNames 中的事件。
+// 处理 AuctionContractCreated 时把新的拍卖合约加入处理器的监听地址，并从创建区块开始重新扫描
+func (ix *AuctionIndexer) Register(handler *EventHandler) error {
+	for _, name := range auctionEventNames {
+		onEvent := func(event *DecodedEvent) error {
+			created, err := ix.apply(event.Raw)
+			if err != nil || created == nil {
+				return err
+			}
+			log.Printf("发现新的拍卖合约 %s（创建者 %s，区块 %d）", created.AuctionContract.Hex(), created.Creator.Hex(), event.Raw.BlockNumber)
+			return handler.AddAddresses([]common.Address{created.AuctionContract}, event.Raw.BlockNumber)
+		}
+		onRollback := func(event *DecodedEvent) error {
+			return ix.revert(event.Raw)
+		}
+		if err := handler.Handle(name, onEvent, onRollback); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply 将一条日志记入索引，同一条日志重复记入不会产生重复数据；
+// 日志为工厂合约新创建拍卖合约的事件时返回该事件
+func (ix *AuctionIndexer) apply(raw types.Log) (*Auction.AuctionFactoryAuctionContractCreated, error) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	if raw.Address == ix.factory {
+		created, err := ix.factoryP.ParseAuctionContractCreated(raw)
+		if err != nil {
+			return nil, err
+		}
+		// 已记录的合约同样返回，回调重试时会再次加入监听地址（AddAddresses 对已监听的地址不做处理）
+		if _, ok := ix.contracts[created.AuctionContract]; !ok {
+			ix.contracts[created.AuctionContract] = created
+		}
+		return created, nil
+	}
+
+	if len(raw.Topics) == 0 {
+		return nil, errors.New("anonymous logs are not supported")
+	}
+	// 监听地址只包含工厂创建的合约，这里再检查一次，例如创建合约的事件已被重组回滚
+	if _, ok := ix.contracts[raw.Address]; !ok {
+		log.Printf("忽略非工厂创建的合约 %s 的事件（交易 %s）", raw.Address.Hex(), raw.TxHash.Hex())
+		return nil, nil
+	}
+
+	switch ix.events[raw.Topics[0]] {
+	case "AuctionCreated":
+		event, err := ix.auctionP.ParseAuctionCreated(raw)
+		if err != nil {
+			return nil, err
+		}
+		ix.stateLocked(raw.Address, event.AuctionId).created = event
+	case "BidPlaced":
+		event, err := ix.auctionP.ParseBidPlaced(raw)
+		if err != nil {
+			return nil, err
+		}
+		state := ix.stateLocked(raw.Address, event.AuctionId)
+		state.bids = appendOnce(state.bids, event, func(e *Auction.NFTAuctionBidPlaced) types.Log { return e.Raw })
+	case "BidWithdrawn":
+		event, err := ix.auctionP.ParseBidWithdrawn(raw)
+		if err != nil {
+			return nil, err
+		}
+		state := ix.stateLocked(raw.Address, event.AuctionId)
+		state.refunds = appendOnce(state.refunds, event, func(e *Auction.NFTAuctionBidWithdrawn) types.Log { return e.Raw })
+	case "AuctionEnded":
+		event, err := ix.auctionP.ParseAuctionEnded(raw)
+		if err != nil {
+			return nil, err
+		}
+		ix.stateLocked(raw.Address, event.AuctionId).ended = event
+	}
+	return nil, nil
+}
+
+// revert 从索引中移除被链重组回滚的日志
+func (ix *AuctionIndexer) revert(raw types.Log) error {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	if raw.Address == ix.factory {
+		created, err := ix.factoryP.ParseAuctionContractCreated(raw)
+		if err != nil {
+			return err
+		}
+		if current, ok := ix.contracts[created.AuctionContract]; ok && sameLog(current.Raw, raw) {
+			delete(ix.contracts, created.AuctionContract)
+			for key := range ix.auctions {
+				if key.contract == created.AuctionContract {
+					delete(ix.auctions, key)
+				}
+			}
+		}
+		return nil
+	}
+
+	// 事件的第一个indexed参数都是拍卖ID
+	if len(raw.Topics) < 2 {
+		return nil
+	}
+	state, ok := ix.auctions[newAuctionKey(raw.Address, raw.Topics[1].Big())]
+	if !ok {
+		return nil
+	}
+	if state.created != nil && sameLog(state.created.Raw, raw) {
+		state.created = nil
+	}
+	if state.ended != nil && sameLog(state.ended.Raw, raw) {
+		state.ended = nil
+	}
+	state.bids = slices.DeleteFunc(state.bids, func(e *Auction.NFTAuctionBidPlaced) bool { return sameLog(e.Raw, raw) })
+	state.refunds = slices.DeleteFunc(state.refunds, func(e *Auction.NFTAuctionBidWithdrawn) bool { return sameLog(e.Raw, raw) })
+	return nil
+}
+
+// stateLocked 返回拍卖的状态，不存在时创建，调用方需持有 mu
+func (ix *AuctionIndexer) stateLocked(contract common.Address, id *big.Int) *auctionState {
+	key := newAuctionKey(contract, id)
+	state, ok := ix.auctions[key]
+	if !ok {
+		state = &auctionState{contract: contract, id: new(big.Int).Set(id)}
+		ix.auctions[key] = state
+	}
+	return state
+}
+
+// RefreshDetails 读取未成交拍卖的起拍价、结束时间、出价代币和是否已结束，用于判断没有 AuctionEnded 事件的拍卖结果
+func (ix *AuctionIndexer) RefreshDetails(ctx context.Context, caller bind.ContractCaller) error {
+	ix.mu.RLock()
+	var pending []*auctionState
+	for _, state := range ix.auctions {
+		if state.ended == nil && (state.details == nil || !state.details.Ended) {
+			pending = append(pending, state)
+		}
+	}
+	ix.mu.RUnlock()
+
+	// 一场拍卖读取失败时继续读取其他拍卖，最后返回所有错误
+	var errs []error
+	for _, state := range pending {
+		instance, err := Auction.NewNFTAuctionCaller(state.contract, caller)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		info, err := instance.Auctions(&bind.CallOpts{Context: ctx}, state.id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("read auction %s#%s: %w", state.contract.Hex(), state.id, err))
+			continue
+		}
+		details := &AuctionDetails{
+			StartingBid: info.StartingBid,
+			EndTime:     time.Unix(info.EndTime.Int64(), 0),
+			BidToken:    info.BidToken,
+			Ended:       info.Ended,
+		}
+
+		ix.mu.Lock()
+		state.details = details
+		ix.mu.Unlock()
+	}
+	return errors.Join(errs...)
+}
+
+// Auctions 返回所有拍卖的摘要，按拍卖合约地址和拍卖ID排序，now 用于判断拍卖是否已过结束时间
+func (ix *AuctionIndexer) Auctions(now time.Time) []AuctionSummary {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	summaries := make([]AuctionSummary, 0, len(ix.auctions))
+	for _, state := range ix.auctions {
+		summaries = append(summaries, state.summary(now))
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if c := summaries[i].Contract.Cmp(summaries[j].Contract); c != 0 {
+			return c < 0
+		}
+		return summaries[i].ID.Cmp(summaries[j].ID) < 0
+	})
+	return summaries
+}
+
+// Auction 返回指定拍卖的摘要
+func (ix *AuctionIndexer) Auction(contract common.Address, id *big.Int, now time.Time) (AuctionSummary, bool) {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	state, ok := ix.auctions[newAuctionKey(contract, id)]
+	if !ok {
+		return AuctionSummary{}, false
+	}
+	return state.summary(now), true
+}
+
+// summary 按链上顺序计算最高出价、出价历史和结果，调用方需持有读锁
+func (s *auctionState) summary(now time.Time) AuctionSummary {
+	summary := AuctionSummary{
+		Contract: s.contract,
+		ID:       new(big.Int).Set(s.id),
+		Details:  s.details,
+		Status:   AuctionOpen,
+	}
+	if s.created != nil {
+		summary.Seller = s.created.Seller
+		summary.NFTContract = s.created.NftContract
+		summary.TokenID = s.created.TokenId
+		summary.CreatedBlock = s.created.Raw.BlockNumber
+	}
+
+	bids := slices.Clone(s.bids)
+	slices.SortFunc(bids, func(a, b *Auction.NFTAuctionBidPlaced) int { return compareLogs(a.Raw, b.Raw) })
+	for _, bid := range bids {
+		summary.Bids = append(summary.Bids, AuctionBid{
+			Bidder:   bid.Bidder,
+			Amount:   bid.Amount,
+			Block:    bid.Raw.BlockNumber,
+			TxHash:   bid.Raw.TxHash,
+			LogIndex: bid.Raw.Index,
+		})
+	}
+	// 每次退款对应被超过的最高出价：退款事件在新出价之前，匹配同一出价者和金额的最早一次未退款出价
+	for _, refund := range s.refunds {
+		for i := range summary.Bids {
+			bid := &summary.Bids[i]
+			if !bid.Refunded && bid.Bidder == refund.Bidder && bid.Amount.Cmp(refund.Amount) == 0 {
+				bid.Refunded = true
+				break
+			}
+		}
+	}
+	// 合约要求每次出价都高于当前最高价，最后一次出价即为最高出价
+	if n := len(summary.Bids); n > 0 {
+		summary.HighestBid = summary.Bids[n-1].Amount
+		summary.HighestBidder = summary.Bids[n-1].Bidder
+	}
+
+	switch {
+	case s.ended != nil:
+		summary.Status = AuctionSold
+		summary.Winner = s.ended.Winner
+		summary.FinalPrice = s.ended.Amount
+	case s.details == nil:
+	case s.details.Ended || !now.Before(s.details.EndTime):
+		// 没有出价的拍卖结束时不产生事件，只能根据合约状态判断
+		if len(summary.Bids) > 0 {
+			summary.Status = AuctionAwaitingEnd
+		} else {
+			summary.Status = AuctionUnsold
+		}
+	}
+	return summary
+}
+
+// ServeHTTP 以JSON提供拍卖索引：/auctions 返回所有拍卖，/auctions/{合约地址}/{拍卖ID} 返回一场拍卖
+func (ix *AuctionIndexer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case len(parts) == 1 && parts[0] == "auctions":
+		json.NewEncoder(w).Encode(newAuctionOutputs(ix.Auctions(time.Now())))
+	case len(parts) == 3 && parts[0] == "auctions" && common.IsHexAddress(parts[1]):
+		id, ok := new(big.Int).SetString(parts[2], 10)
+		if !ok {
+			http.Error(w, `{"error":"invalid auction id"}`, http.StatusBadRequest)
+			return
+		}
+		summary, ok := ix.Auction(common.HexToAddress(parts[1]), id, time.Now())
+		if !ok {
+			http.Error(w, `{"error":"auction not found"}`, http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(newAuctionOutput(summary))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// auctionBidOutput 出价的JSON输出格式，金额以十进制字符串输出避免精度丢失
+type auctionBidOutput struct {
+	Bidder   string `json:"bidder"`
+	Amount   string `json:"amount"`
+	Refunded bool   `json:"refunded"`
+	Block    uint64 `json:"block"`
+	TxHash   string `json:"tx_hash"`
+	LogIndex uint   `json:"log_index"`
+}
+
+// auctionOutput 拍卖摘要的JSON输出格式
+type auctionOutput struct {
+	Contract      string             `json:"contract"`
+	ID            string             `json:"id"`
+	Status        AuctionStatus      `json:"status"`
+	Seller        string             `json:"seller,omitempty"`
+	NFTContract   string             `json:"nft_contract,omitempty"`
+	TokenID       string             `json:"token_id,omitempty"`
+	CreatedBlock  uint64             `json:"created_block,omitempty"`
+	BidToken      string             `json:"bid_token,omitempty"`
+	StartingBid   string             `json:"starting_bid,omitempty"`
+	EndTime       string             `json:"end_time,omitempty"`
+	HighestBid    string             `json:"highest_bid,omitempty"`
+	HighestBidder string             `json:"highest_bidder,omitempty"`
+	Winner        string             `json:"winner,omitempty"`
+	FinalPrice    string             `json:"final_price,omitempty"`
+	Bids          []auctionBidOutput `json:"bids"`
+}
+
+func newAuctionOutput(s AuctionSummary) auctionOutput {
+	out := auctionOutput{
+		Contract:     s.Contract.Hex(),
+		ID:           s.ID.String(),
+		Status:       s.Status,
+		CreatedBlock: s.CreatedBlock,
+		Bids:         []auctionBidOutput{},
+	}
+	if s.CreatedBlock > 0 {
+		out.Seller = s.Seller.Hex()
+		out.NFTContract = s.NFTContract.Hex()
+		out.TokenID = s.TokenID.String()
+	}
+	if s.Details != nil {
+		out.BidToken = s.Details.BidToken.Hex()
+		out.StartingBid = s.Details.StartingBid.String()
+		out.EndTime = s.Details.EndTime.UTC().Format(time.RFC3339)
+	}
+	if s.HighestBid != nil {
+		out.HighestBid = s.HighestBid.String()
+		out.HighestBidder = s.HighestBidder.Hex()
+	}
+	if s.FinalPrice != nil {
+		out.Winner = s.Winner.Hex()
+		out.FinalPrice = s.FinalPrice.String()
+	}
+	for _, bid := range s.Bids {
+		out.Bids = append(out.Bids, auctionBidOutput{
+			Bidder:   bid.Bidder.Hex(),
+			Amount:   bid.Amount.String(),
+			Refunded: bid.Refunded,
+			Block:    bid.Block,
+			TxHash:   bid.TxHash.Hex(),
+			LogIndex: bid.LogIndex,
+		})
+	}
+	return out
+}
+
+func newAuctionOutputs(summaries []AuctionSummary) []auctionOutput {
+	outputs := make([]auctionOutput, len(summaries))
+	for i, summary := range summaries {
+		outputs[i] = newAuctionOutput(summary)
+	}
+	return outputs
+}
+
+// appendOnce 追加事件，同一条日志（交易、日志索引和区块哈希相同）已存在时不重复追加
+func appendOnce[T any](events []*T, event *T, raw func(*T) types.Log) []*T {
+	for _, existing := range events {
+		if sameLog(raw(existing), raw(event)) {
+			return events
+		}
+	}
+	return append(events, event)
+}
+
+// sameLog 判断两条日志是否为同一区块中的同一条日志
+func sameLog(a, b types.Log) bool {
+	return a.TxHash == b.TxHash && a.Index == b.Index && a.BlockHash == b.BlockHash
+}
+
+// compareLogs 按区块号和日志索引比较两条日志的链上顺序
+func compareLogs(a, b types.Log) int {
+	if a.BlockNumber != b.BlockNumber {
+		if a.BlockNumber < b.BlockNumber {
+			return -1
+		}
+		return 1
+	}
+	return int(a.Index) - int(b.Index)
+}
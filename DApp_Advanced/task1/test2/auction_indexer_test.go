@@ -0,0 +1,335 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	Auction "counter/auction"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	testFactory      = common.HexToAddress("0x00000000000000000000000000000000000fac70")
+	testAuction      = common.HexToAddress("0x00000000000000000000000000000000000a0c71")
+	testStrayAuction = common.HexToAddress("0x00000000000000000000000000000000000bad00")
+	testSeller       = common.HexToAddress("0x0000000000000000000000000000000000005e11")
+	testNFT          = common.HexToAddress("0x00000000000000000000000000000000000000f7")
+	testBidder1      = common.HexToAddress("0x00000000000000000000000000000000000000b1")
+	testBidder2      = common.HexToAddress("0x00000000000000000000000000000000000000b2")
+)
+
+// auctionLog 按ABI构造一条事件日志，indexed参数放入topics，其余参数编码到data
+func auctionLog(t *testing.T, meta string, contract common.Address, name string, block uint64, index uint, args ...interface{}) types.Log {
+	t.Helper()
+	parsed, err := abi.JSON(strings.NewReader(meta))
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := parsed.Events[name]
+	topics := []common.Hash{event.ID}
+	var data []interface{}
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			data = append(data, args[i])
+			continue
+		}
+		switch v := args[i].(type) {
+		case common.Address:
+			topics = append(topics, common.BytesToHash(v.Bytes()))
+		case *big.Int:
+			topics = append(topics, common.BigToHash(v))
+		default:
+			t.Fatalf("unsupported indexed argument %T", v)
+		}
+	}
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.Log{
+		Address:     contract,
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: block,
+		BlockHash:   common.BigToHash(new(big.Int).SetUint64(block)),
+		TxHash:      common.BigToHash(new(big.Int).SetUint64(block*100 + uint64(index))),
+		Index:       index,
+	}
+}
+
+// testAuctionLogs 工厂在区块10创建拍卖合约，拍卖0收到两次出价后成交，拍卖1没有出价
+func testAuctionLogs(t *testing.T) []types.Log {
+	t.Helper()
+	factoryABI, auctionABI := Auction.AuctionFactoryMetaData.ABI, Auction.NFTAuctionMetaData.ABI
+	id0, id1 := big.NewInt(0), big.NewInt(1)
+	return []types.Log{
+		auctionLog(t, factoryABI, testFactory, "AuctionContractCreated", 10, 0, testAuction, testSeller),
+		auctionLog(t, auctionABI, testAuction, "AuctionCreated", 11, 0, id0, testSeller, testNFT, big.NewInt(7)),
+		auctionLog(t, auctionABI, testAuction, "BidPlaced", 12, 0, id0, testBidder1, big.NewInt(100)),
+		// 其他人部署的同类合约，不是工厂创建的，不应被索引
+		auctionLog(t, auctionABI, testStrayAuction, "BidPlaced", 12, 1, id0, testBidder1, big.NewInt(1)),
+		auctionLog(t, auctionABI, testAuction, "BidWithdrawn", 13, 0, id0, testBidder1, big.NewInt(100)),
+		auctionLog(t, auctionABI, testAuction, "BidPlaced", 13, 1, id0, testBidder2, big.NewInt(150)),
+		auctionLog(t, auctionABI, testAuction, "AuctionCreated", 14, 0, id1, testSeller, testNFT, big.NewInt(8)),
+		auctionLog(t, auctionABI, testAuction, "AuctionEnded", 20, 0, id0, testBidder2, big.NewInt(150)),
+	}
+}
+
+// startAuctionIndexer 使用轮询的假客户端启动拍卖索引器
+func startAuctionIndexer(t *testing.T, client ChainClient, store EventStore) (*AuctionIndexer, *EventHandler) {
+	t.Helper()
+	indexer, err := NewAuctionIndexer(testFactory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := indexer.Restore(store); err != nil {
+		t.Fatal(err)
+	}
+	abiJSON, err := auctionEventsABI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler, err := NewEventHandler(client, store, abiJSON, indexer.Addresses(), auctionEventNames, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := indexer.Register(handler); err != nil {
+		t.Fatal(err)
+	}
+	handler.SetStartBlock(1)
+	handler.SetPollInterval(10 * time.Millisecond)
+	if err := handler.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { handler.Stop() })
+	return indexer, handler
+}
+
+// waitAuction 等待拍卖的摘要满足条件
+func waitAuction(t *testing.T, indexer *AuctionIndexer, id int64, cond func(AuctionSummary) bool) AuctionSummary {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if summary, ok := indexer.Auction(testAuction, big.NewInt(id), time.Now()); ok && cond(summary) {
+			return summary
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for auction %d", id)
+	return AuctionSummary{}
+}
+
+func TestAuctionIndexerTracksFactoryAuctions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "auction_store.log")
+	store := openSimStore(t, path)
+	client := &fakeChainClient{head: 20, logs: testAuctionLogs(t)}
+	indexer, handler := startAuctionIndexer(t, client, store)
+
+	// 第一次扫描只能查到工厂合约的事件，发现拍卖合约后从创建区块重新扫描
+	sold := func(s AuctionSummary) bool { return s.Status == AuctionSold && len(s.Bids) == 2 }
+	summary := waitAuction(t, indexer, 0, sold)
+	if summary.Seller != testSeller || summary.NFTContract != testNFT || summary.TokenID.Int64() != 7 || summary.CreatedBlock != 11 {
+		t.Errorf("auction info = %+v", summary)
+	}
+	if summary.HighestBid.Int64() != 150 || summary.HighestBidder != testBidder2 {
+		t.Errorf("highest bid = %s by %s, want 150 by bidder2", summary.HighestBid, summary.HighestBidder.Hex())
+	}
+	if !summary.Bids[0].Refunded || summary.Bids[1].Refunded || summary.Bids[0].Bidder != testBidder1 {
+		t.Errorf("bid history = %+v", summary.Bids)
+	}
+	if summary.Winner != testBidder2 || summary.FinalPrice.Int64() != 150 {
+		t.Errorf("outcome = %s for %s", summary.Winner.Hex(), summary.FinalPrice)
+	}
+	waitAuction(t, indexer, 1, func(s AuctionSummary) bool { return s.CreatedBlock == 14 })
+	if _, ok := indexer.Auction(testStrayAuction, big.NewInt(0), time.Now()); ok {
+		t.Error("indexed an auction contract that was not created by the factory")
+	}
+
+	// 之后创建的拍卖同样被跟踪
+	client.mu.Lock()
+	client.logs = append(client.logs, auctionLog(t, Auction.NFTAuctionMetaData.ABI, testAuction, "BidPlaced", 21, 0, big.NewInt(1), testBidder1, big.NewInt(5)))
+	client.head = 21
+	client.mu.Unlock()
+	waitAuction(t, indexer, 1, func(s AuctionSummary) bool { return s.HighestBid != nil && s.HighestBid.Int64() == 5 })
+
+	if err := handler.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	store.Close()
+
+	// 重启后从事件存储恢复索引和监听地址，不重复记录出价
+	store = openSimStore(t, path)
+	defer store.Close()
+	restored, err := NewAuctionIndexer(testFactory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := restored.Restore(store); err != nil {
+		t.Fatal(err)
+	}
+	if addresses := restored.Addresses(); len(addresses) != 2 || addresses[1] != testAuction {
+		t.Errorf("restored addresses = %v", addresses)
+	}
+	summaries := restored.Auctions(time.Now())
+	if len(summaries) != 2 || summaries[0].Status != AuctionSold || len(summaries[0].Bids) != 2 || len(summaries[1].Bids) != 1 {
+		t.Fatalf("restored summaries = %+v", summaries)
+	}
+}
+
+func TestAuctionIndexerRevertsReorgedEvents(t *testing.T) {
+	indexer, err := NewAuctionIndexer(testFactory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logs := testAuctionLogs(t)
+	for _, raw := range logs {
+		if _, err := indexer.apply(raw); err != nil {
+			t.Fatal(err)
+		}
+		// 重复记入同一条日志不会产生重复出价
+		if _, err := indexer.apply(raw); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 成交事件和最后一次出价所在的区块被重组移除
+	for _, raw := range []types.Log{logs[7], logs[5], logs[4]} {
+		if err := indexer.revert(raw); err != nil {
+			t.Fatal(err)
+		}
+	}
+	summary, _ := indexer.Auction(testAuction, big.NewInt(0), time.Now())
+	if summary.Status != AuctionOpen || summary.FinalPrice != nil {
+		t.Errorf("status = %s, final price %s; want open", summary.Status, summary.FinalPrice)
+	}
+	if len(summary.Bids) != 1 || summary.Bids[0].Refunded || summary.HighestBid.Int64() != 100 || summary.HighestBidder != testBidder1 {
+		t.Errorf("bids after reorg = %+v", summary.Bids)
+	}
+
+	// 创建拍卖合约的事件被回滚后，该合约的拍卖全部移除
+	if err := indexer.revert(logs[0]); err != nil {
+		t.Fatal(err)
+	}
+	if len(indexer.Auctions(time.Now())) != 0 || len(indexer.Addresses()) != 1 {
+		t.Error("auctions of a reorged contract are still indexed")
+	}
+}
+
+// auctionCaller 模拟拍卖合约的 auctions(id) 调用
+type auctionCaller struct {
+	endTime int64
+	ended   bool
+	failID  *big.Int // 读取该拍卖时返回错误
+}
+
+func (c *auctionCaller) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (c *auctionCaller) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	parsed, err := Auction.NFTAuctionMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method := parsed.Methods["auctions"]
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	if c.failID != nil && args[0].(*big.Int).Cmp(c.failID) == 0 {
+		return nil, errors.New("connection reset by peer")
+	}
+	return method.Outputs.Pack(
+		testSeller, testNFT, big.NewInt(8), big.NewInt(0), big.NewInt(c.endTime),
+		big.NewInt(10), big.NewInt(0), common.Address{}, common.Address{}, c.ended,
+	)
+}
+
+func TestAuctionIndexerRefreshContinuesAfterFailure(t *testing.T) {
+	indexer, err := NewAuctionIndexer(testFactory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, raw := range testAuctionLogs(t)[:7] {
+		if _, err := indexer.apply(raw); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 一场拍卖读取失败不影响其他拍卖，错误在最后返回
+	err = indexer.RefreshDetails(context.Background(), &auctionCaller{endTime: 1_000_000, failID: big.NewInt(0)})
+	if err == nil || !strings.Contains(err.Error(), "#0") {
+		t.Errorf("err = %v, want error for auction 0", err)
+	}
+	if summary, _ := indexer.Auction(testAuction, big.NewInt(1), time.Now()); summary.Details == nil {
+		t.Error("auction 1 was not refreshed after auction 0 failed")
+	}
+}
+
+func TestAuctionIndexerOutcomeWithoutBids(t *testing.T) {
+	indexer, err := NewAuctionIndexer(testFactory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, raw := range testAuctionLogs(t)[:7] {
+		if _, err := indexer.apply(raw); err != nil {
+			t.Fatal(err)
+		}
+	}
+	now := time.Unix(1_000_000, 0)
+	caller := &auctionCaller{endTime: now.Unix() + 60}
+	if err := indexer.RefreshDetails(context.Background(), caller); err != nil {
+		t.Fatal(err)
+	}
+	status := func(id int64, at time.Time) AuctionStatus {
+		summary, _ := indexer.Auction(testAuction, big.NewInt(id), at)
+		return summary.Status
+	}
+
+	if got := status(1, now); got != AuctionOpen {
+		t.Errorf("before end time: %s, want open", got)
+	}
+	// 过了结束时间：没有出价的拍卖流拍，有出价的等待 endAuction
+	later := now.Add(2 * time.Minute)
+	if got := status(1, later); got != AuctionUnsold {
+		t.Errorf("no bids after end time: %s, want unsold", got)
+	}
+	if got := status(0, later); got != AuctionAwaitingEnd {
+		t.Errorf("bids after end time: %s, want awaiting_end", got)
+	}
+	summary, _ := indexer.Auction(testAuction, big.NewInt(1), now)
+	if summary.Details == nil || summary.Details.StartingBid.Int64() != 10 || summary.Details.EndTime.Unix() != caller.endTime {
+		t.Errorf("details = %+v", summary.Details)
+	}
+
+	// HTTP查询
+	server := httptest.NewServer(indexer)
+	defer server.Close()
+	resp, err := http.Get(server.URL + "/auctions/" + testAuction.Hex() + "/0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var out auctionOutput
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	if out.HighestBid != "150" || len(out.Bids) != 2 || !out.Bids[0].Refunded {
+		t.Errorf("GET auction = %+v", out)
+	}
+	if resp, err := http.Get(server.URL + "/auctions/" + testAuction.Hex() + "/9"); err != nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unknown auction: %v, %v", resp.StatusCode, err)
+	}
+}
@@ -0,0 +1,887 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	Counter "counter/counter"
+	"counter/stakeclient"
+	"ethtx"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// auctionDetailsInterval auctions 命令读取拍卖合约状态（结束时间、是否已结束）的间隔
+const auctionDetailsInterval = time.Minute
+
+// receiptTimeout 等待交易上链的默认超时时间
+const receiptTimeout = 5 * time.Minute
+
+// historyBatchSize history 命令每次 eth_getLogs 查询的区块数
+const historyBatchSize = defaultBackfillBatchSize
+
+// counterBackend CLI 使用的节点接口，*ethclient.Client 和模拟后端的客户端都实现了该接口
+type counterBackend interface {
+	bind.ContractBackend
+	BlockNumber(ctx context.Context) (uint64, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// command CLI 子命令
+type command struct {
+	name  string
+	usage string
+	run   func(c *cli, ctx context.Context, args []string) error
+}
+
+// commands 所有子命令，按帮助中的显示顺序排列
+var commands = []command{
+	{"deploy", "部署新的Counter合约", (*cli).deploy},
+	{"get", "读取当前计数", (*cli).get},
+	{"increment", "计数加1", transactCommand("increment")},
+	{"decrement", "计数减1（计数为0时合约会回滚）", transactCommand("decrement")},
+	{"reset", "计数重置为0", transactCommand("reset")},
+	{"watch", "可靠地监听changeCount事件，直到 Ctrl+C", (*cli).watch},
+	{"history", "查询区块范围内的changeCount事件：history [-from N] [-to N]", (*cli).history},
+	{"auctions", "索引NFT拍卖工厂创建的拍卖，直到 Ctrl+C 后输出结果：auctions [-from N]", (*cli).auctions},
+	{"stake", "质押到MetaNodeStake质押池，代币池授权不足时先授权：stake [-pool N] <数量>", (*cli).stake},
+	{"unstake", "申请解除质押，锁定期后用 withdraw 提取：unstake [-pool N] <数量>", (*cli).unstake},
+	{"withdraw", "提取已过锁定期的解除质押：withdraw [-pool N]", (*cli).withdraw},
+	{"claim", "领取质押池的MetaNode奖励：claim [-pool N]", (*cli).claim},
+	{"positions", "查询账户在各质押池的质押、待领取奖励和可提取数量：positions [-account 地址]", (*cli).positions},
+}
+
+// cli Counter命令行工具的运行环境
+type cli struct {
+	cfg     *Config
+	backend counterBackend
+	chainID *big.Int
+	json    bool      // 以JSON输出结果（每个结果一行），便于脚本处理
+	out     io.Writer // 结果输出，日志输出到标准错误
+
+	sender *ethtx.Sender // 第一次发送交易时创建，只读命令不需要签名器
+}
+
+// findCommand 按名称查找子命令
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+// printUsage 输出命令行帮助
+func printUsage(w io.Writer, global *flag.FlagSet) {
+	fmt.Fprintf(w, "用法: counter [全局选项] <命令> [命令选项]\n\n命令:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", cmd.name, cmd.usage)
+	}
+	fmt.Fprintf(w, "\n全局选项:\n")
+	global.SetOutput(w)
+	global.PrintDefaults()
+}
+
+// emit 输出一个结果：JSON模式下输出 v 的JSON，否则按 format 输出文本
+func (c *cli) emit(v interface{}, format string, args ...interface{}) error {
+	if c.json {
+		return json.NewEncoder(c.out).Encode(v)
+	}
+	_, err := fmt.Fprintf(c.out, format, args...)
+	return err
+}
+
+// contract 返回配置的Counter合约实例
+func (c *cli) contract() (*Counter.Counter, error) {
+	if c.cfg.Contracts.Counter == "" {
+		return nil, errors.New("contracts.counter is not configured (set COUNTER_CONTRACT_ADDRESS or run deploy first)")
+	}
+	return Counter.NewCounter(c.cfg.ContractAddress(), c.backend)
+}
+
+// getSender 返回交易发送器，第一次调用时根据配置创建签名器
+func (c *cli) getSender() (*ethtx.Sender, error) {
+	if c.sender == nil {
+		signer, err := ethtx.NewSigner(c.cfg.Signer)
+		if err != nil {
+			return nil, fmt.Errorf("create signer: %w", err)
+		}
+		c.sender = ethtx.NewSender(c.backend, signer, c.chainID)
+	}
+	return c.sender, nil
+}
+
+// txFlags 发送交易的命令共用的选项
+type txFlags struct {
+	confirmations uint64
+	timeout       time.Duration
+}
+
+func newTxFlagSet(name string) (*flag.FlagSet, *txFlags) {
+	flags := &txFlags{}
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Uint64Var(&flags.confirmations, "confirmations", 1, "等待的区块确认数（包含交易所在区块）")
+	fs.DurationVar(&flags.timeout, "timeout", receiptTimeout, "等待交易上链的超时时间")
+	return fs, flags
+}
+
+// txResult 交易命令的输出
+type txResult struct {
+	Action   string  `json:"action"`
+	TxHash   string  `json:"tx_hash"`
+	Block    uint64  `json:"block"`
+	GasUsed  uint64  `json:"gas_used"`
+	Contract string  `json:"contract,omitempty"`
+	Count    string  `json:"count,omitempty"`
+	Pool     *uint64 `json:"pool,omitempty"`   // 质押命令的质押池ID
+	Amount   string  `json:"amount,omitempty"` // 质押命令的数量，按代币精度格式化
+	Symbol   string  `json:"symbol,omitempty"`
+}
+
+// prepareOpts 创建交易选项：按手续费策略设置 EIP-1559 手续费，按调用数据和附带的ETH估算gas限制
+// to 为 nil 时估算部署合约的gas，value 为 nil 时不附带ETH
+func (c *cli) prepareOpts(ctx context.Context, sender *ethtx.Sender, to *common.Address, value *big.Int, data []byte) (*bind.TransactOpts, error) {
+	fees, err := ethtx.SuggestFees(ctx, c.backend, c.cfg.Fees)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("手续费（%s）: %s", c.cfg.Fees.Strategy, fees)
+
+	auth := sender.TransactOpts(ctx)
+	fees.Apply(auth)
+	auth.Value = value
+	auth.GasLimit, err = ethtx.EstimateGasLimit(ctx, c.backend, c.cfg.Gas, ethereum.CallMsg{
+		From:      auth.From,
+		To:        to,
+		GasTipCap: auth.GasTipCap,
+		GasFeeCap: auth.GasFeeCap,
+		Value:     value,
+		Data:      data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("gas限制: %d", auth.GasLimit)
+	return auth, nil
+}
+
+// waitMined 等待交易上链并获得 confirmations 个确认，执行失败时返回包含回滚原因的错误
+func (c *cli) waitMined(ctx context.Context, tx *types.Transaction, flags *txFlags) (*types.Receipt, error) {
+	log.Printf("交易已发送: %s，等待上链...", tx.Hash().Hex())
+	waitCtx, cancel := context.WithTimeout(ctx, flags.timeout)
+	defer cancel()
+	return ethtx.WaitMined(waitCtx, c.backend, tx, flags.confirmations)
+}
+
+// checkSent 检查发送结果：无法确定交易是否已广播（ethtx.ErrSendUncertain）时继续等待这笔交易，不重新发送
+func checkSent(tx *types.Transaction, err error) error {
+	if errors.Is(err, ethtx.ErrSendUncertain) {
+		log.Printf("无法确认交易 %s 是否已广播，继续等待: %v", tx.Hash().Hex(), err)
+		return nil
+	}
+	return err
+}
+
+// deploy 部署新的Counter合约
+func (c *cli) deploy(ctx context.Context, args []string) error {
+	fs, flags := newTxFlagSet("deploy")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	sender, err := c.getSender()
+	if err != nil {
+		return err
+	}
+
+	auth, err := c.prepareOpts(ctx, sender, nil, nil, common.FromHex(Counter.CounterMetaData.Bin))
+	if err != nil {
+		return fmt.Errorf("prepare deploy: %w", err)
+	}
+	var address common.Address
+	tx, err := sender.Transact(ctx, auth, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		var tx *types.Transaction
+		var err error
+		address, tx, _, err = Counter.DeployCounter(opts, c.backend)
+		return tx, err
+	})
+	if err = checkSent(tx, err); err != nil {
+		return fmt.Errorf("deploy Counter: %w", err)
+	}
+	receipt, err := c.waitMined(ctx, tx, flags)
+	if err != nil {
+		return err
+	}
+
+	result := txResult{Action: "deploy", TxHash: tx.Hash().Hex(), Block: receipt.BlockNumber.Uint64(), GasUsed: receipt.GasUsed, Contract: address.Hex()}
+	return c.emit(result, "Counter合约已部署: %s\n  交易: %s\n  区块: %d\n  消耗gas: %d\n将 contracts.counter 或 COUNTER_CONTRACT_ADDRESS 设置为该地址以使用其他命令\n",
+		result.Contract, result.TxHash, result.Block, result.GasUsed)
+}
+
+// get 读取当前计数
+func (c *cli) get(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("get", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	instance, err := c.contract()
+	if err != nil {
+		return err
+	}
+	count, err := instance.GetCount(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("call getCount: %w", err)
+	}
+	return c.emit(map[string]string{"contract": c.cfg.ContractAddress().Hex(), "count": count.String()}, "%s\n", count)
+}
+
+// transactCommand 返回调用Counter写方法 method 的命令
+func transactCommand(method string) func(c *cli, ctx context.Context, args []string) error {
+	return func(c *cli, ctx context.Context, args []string) error {
+		fs, flags := newTxFlagSet(method)
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		instance, err := c.contract()
+		if err != nil {
+			return err
+		}
+		sender, err := c.getSender()
+		if err != nil {
+			return err
+		}
+
+		// 估算gas时合约 require 失败会直接报告回滚原因，不会发送注定失败的交易
+		parsed, err := Counter.CounterMetaData.GetAbi()
+		if err != nil {
+			return err
+		}
+		data, err := parsed.Pack(method)
+		if err != nil {
+			return err
+		}
+		contract := c.cfg.ContractAddress()
+		auth, err := c.prepareOpts(ctx, sender, &contract, nil, data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", method, err)
+		}
+
+		raw := &Counter.CounterRaw{Contract: instance}
+		tx, err := sender.Transact(ctx, auth, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return raw.Transact(opts, method)
+		})
+		if err = checkSent(tx, err); err != nil {
+			return fmt.Errorf("%s: %w", method, err)
+		}
+		receipt, err := c.waitMined(ctx, tx, flags)
+		if err != nil {
+			return fmt.Errorf("%s: %w", method, err)
+		}
+
+		result := txResult{Action: method, TxHash: tx.Hash().Hex(), Block: receipt.BlockNumber.Uint64(), GasUsed: receipt.GasUsed}
+		if count, err := instance.GetCount(&bind.CallOpts{Context: ctx, BlockNumber: receipt.BlockNumber}); err == nil {
+			result.Count = count.String()
+		} else {
+			log.Printf("读取计数失败: %v", err)
+		}
+		return c.emit(result, "%s 成功\n  交易: %s\n  区块: %d\n  消耗gas: %d\n  当前计数: %s\n",
+			method, result.TxHash, result.Block, result.GasUsed, result.Count)
+	}
+}
+
+// changeCountEvent changeCount事件的输出格式
+type changeCountEvent struct {
+	Action   string `json:"action"`
+	By       string `json:"by"`
+	NewCount string `json:"new_count"`
+	Block    uint64 `json:"block"`
+	TxHash   string `json:"tx_hash"`
+	LogIndex uint   `json:"log_index"`
+	Removed  bool   `json:"removed,omitempty"` // 事件已被链重组移除
+}
+
+func newChangeCountEvent(event *Counter.CounterChangeCount, removed bool) changeCountEvent {
+	return changeCountEvent{
+		Action:   event.Action,
+		By:       event.By.String(),
+		NewCount: event.NewCount.String(),
+		Block:    event.Raw.BlockNumber,
+		TxHash:   event.Raw.TxHash.Hex(),
+		LogIndex: event.Raw.Index,
+		Removed:  removed,
+	}
+}
+
+// emitEvent 输出一个changeCount事件
+func (c *cli) emitEvent(event changeCountEvent) error {
+	if event.Removed {
+		return c.emit(event, "[区块 %d] changeCount事件已被链重组移除，回滚: %s -> %s（交易 %s）\n",
+			event.Block, event.Action, event.NewCount, event.TxHash)
+	}
+	return c.emit(event, "[区块 %d] %s by %s -> %s（交易 %s）\n",
+		event.Block, event.Action, event.By, event.NewCount, event.TxHash)
+}
+
+// watch 使用事件处理器可靠地监听changeCount事件，直到 ctx 取消（Ctrl+C 或 SIGTERM）
+func (c *cli) watch(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("watch", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if c.cfg.Contracts.Counter == "" {
+		return errors.New("contracts.counter is not configured")
+	}
+
+	// 创建事件处理器，避免事件漏处理或重复处理
+	eventHandler, store := setupEventHandler(c.backend, c.cfg,
+		func(event *Counter.CounterChangeCount) error {
+			return c.emitEvent(newChangeCountEvent(event, false))
+		},
+		func(event *Counter.CounterChangeCount) error {
+			return c.emitEvent(newChangeCountEvent(event, true))
+		},
+	)
+	defer store.Close()
+
+	if err := eventHandler.Start(ctx); err != nil {
+		return fmt.Errorf("start event handler: %w", err)
+	}
+	if c.cfg.Events.MetricsAddr != "" {
+		go func() {
+			if err := eventHandler.ServeMetrics(ctx, c.cfg.Events.MetricsAddr); err != nil {
+				log.Printf("指标服务退出: %v", err)
+			}
+		}()
+	}
+
+	// 保持运行直到按下 Ctrl+C 或收到 SIGTERM
+	log.Println("正在监听changeCount事件，按Ctrl+C停止...")
+	<-ctx.Done()
+
+	// 取消订阅，等待正在处理的事件完成并将事件存储落盘
+	log.Println("收到退出信号，正在停止事件处理器...")
+	return eventHandler.Stop()
+}
+
+// history 查询 [from, to] 区块范围内的changeCount事件，to 为0时查询到最新区块
+func (c *cli) history(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("history", flag.ContinueOnError)
+	from := fs.Uint64("from", c.cfg.Events.StartBlock, "起始区块（包含），默认 events.start_block")
+	to := fs.Uint64("to", 0, "结束区块（包含），0表示最新区块")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	instance, err := c.contract()
+	if err != nil {
+		return err
+	}
+
+	end := *to
+	if end == 0 {
+		if end, err = c.backend.BlockNumber(ctx); err != nil {
+			return fmt.Errorf("get block number: %w", err)
+		}
+	}
+	if *from > end {
+		return fmt.Errorf("-from %d is after -to %d", *from, end)
+	}
+
+	events, err := c.filterChangeCount(ctx, instance, *from, end)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		if err := c.emitEvent(event); err != nil {
+			return err
+		}
+	}
+	if !c.json {
+		fmt.Fprintf(c.out, "区块 %d-%d 共 %d 个changeCount事件\n", *from, end, len(events))
+	}
+	return nil
+}
+
+// filterChangeCount 按 historyBatchSize 分批查询changeCount事件，按区块和日志顺序返回
+func (c *cli) filterChangeCount(ctx context.Context, instance *Counter.Counter, from, to uint64) ([]changeCountEvent, error) {
+	var events []changeCountEvent
+	for start := from; start <= to; start += historyBatchSize {
+		end := start + historyBatchSize - 1
+		if end > to || end < start {
+			end = to
+		}
+		iterator, err := instance.FilterChangeCount(&bind.FilterOpts{Start: start, End: &end, Context: ctx})
+		if err != nil {
+			return nil, fmt.Errorf("filter changeCount in blocks %d-%d: %w", start, end, err)
+		}
+		for iterator.Next() {
+			events = append(events, newChangeCountEvent(iterator.Event, iterator.Event.Raw.Removed))
+		}
+		err = iterator.Error()
+		iterator.Close()
+		if err != nil {
+			return nil, fmt.Errorf("filter changeCount in blocks %d-%d: %w", start, end, err)
+		}
+		if end == to {
+			break
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].Block != events[j].Block {
+			return events[i].Block < events[j].Block
+		}
+		return events[i].LogIndex < events[j].LogIndex
+	})
+	return events, nil
+}
+
+// auctions 使用事件处理器索引拍卖工厂创建的所有拍卖合约，直到 ctx 取消（Ctrl+C 或 SIGTERM），
+// 退出时输出每场拍卖的最高出价、出价历史和结果；配置了 events.metrics_addr 时同时提供 /auctions 查询
+func (c *cli) auctions(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("auctions", flag.ContinueOnError)
+	from := fs.Uint64("from", c.cfg.Events.StartBlock, "没有检查点时开始扫描的区块（工厂合约的部署区块），默认 events.start_block")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if c.cfg.Contracts.AuctionFactory == "" {
+		return errors.New("contracts.auction_factory is not configured (set COUNTER_AUCTION_FACTORY)")
+	}
+
+	indexer, eventHandler, store, err := setupAuctionIndexer(c.backend, c.cfg)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	eventHandler.SetStartBlock(*from)
+
+	if err := eventHandler.Start(ctx); err != nil {
+		return fmt.Errorf("start event handler: %w", err)
+	}
+	if c.cfg.Events.MetricsAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/auctions", indexer)
+		mux.Handle("/auctions/", indexer)
+		mux.Handle("/", eventHandler.MetricsHandler())
+		go func() {
+			log.Printf("拍卖查询服务已启动: http://%s/auctions", c.cfg.Events.MetricsAddr)
+			if err := serveHTTP(ctx, c.cfg.Events.MetricsAddr, mux); err != nil {
+				log.Printf("拍卖查询服务退出: %v", err)
+			}
+		}()
+	}
+
+	// 没有出价的拍卖结束时不产生事件，定期读取合约状态判断拍卖结果
+	log.Printf("正在索引拍卖工厂 %s 创建的拍卖，按Ctrl+C停止...", c.cfg.AuctionFactoryAddress().Hex())
+	for {
+		if err := indexer.RefreshDetails(ctx, c.backend); err != nil && ctx.Err() == nil {
+			log.Printf("读取拍卖状态失败: %v", err)
+		}
+		if !sleepContext(ctx, auctionDetailsInterval) {
+			break
+		}
+	}
+
+	log.Println("收到退出信号，正在停止事件处理器...")
+	if err := eventHandler.Stop(); err != nil {
+		return err
+	}
+	refreshCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := indexer.RefreshDetails(refreshCtx, c.backend); err != nil {
+		log.Printf("读取拍卖状态失败: %v", err)
+	}
+
+	summaries := indexer.Auctions(time.Now())
+	for _, summary := range summaries {
+		if err := c.emitAuction(summary); err != nil {
+			return err
+		}
+	}
+	if !c.json {
+		fmt.Fprintf(c.out, "共 %d 场拍卖\n", len(summaries))
+	}
+	return nil
+}
+
+// emitAuction 输出一场拍卖的摘要
+func (c *cli) emitAuction(summary AuctionSummary) error {
+	out := newAuctionOutput(summary)
+	if c.json {
+		return json.NewEncoder(c.out).Encode(out)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "拍卖 %s #%s [%s]\n", out.Contract, out.ID, out.Status)
+	if out.Seller != "" {
+		fmt.Fprintf(&b, "  NFT: %s #%s，卖家 %s（区块 %d）\n", out.NFTContract, out.TokenID, out.Seller, out.CreatedBlock)
+	}
+	if out.EndTime != "" {
+		fmt.Fprintf(&b, "  起拍价: %s，出价代币: %s，结束时间: %s\n", out.StartingBid, out.BidToken, out.EndTime)
+	}
+	switch {
+	case out.FinalPrice != "":
+		fmt.Fprintf(&b, "  成交: %s 以 %s 买下\n", out.Winner, out.FinalPrice)
+	case out.HighestBid != "":
+		fmt.Fprintf(&b, "  当前最高出价: %s（%s）\n", out.HighestBid, out.HighestBidder)
+	default:
+		fmt.Fprintf(&b, "  暂无出价\n")
+	}
+	for _, bid := range out.Bids {
+		refunded := ""
+		if bid.Refunded {
+			refunded = "（已退回）"
+		}
+		fmt.Fprintf(&b, "    [区块 %d] %s 出价 %s%s\n", bid.Block, bid.Bidder, bid.Amount, refunded)
+	}
+	_, err := io.WriteString(c.out, b.String())
+	return err
+}
+
+// stakeClient 返回配置的MetaNodeStake合约客户端
+func (c *cli) stakeClient() (*stakeclient.Client, error) {
+	if c.cfg.Contracts.MetaNodeStake == "" {
+		return nil, errors.New("contracts.metanode_stake is not configured (set COUNTER_METANODE_STAKE)")
+	}
+	return stakeclient.New(c.cfg.MetaNodeStakeAddress(), c.backend)
+}
+
+// newStakeFlagSet 质押交易命令的选项：交易选项和质押池ID
+func newStakeFlagSet(name string) (*flag.FlagSet, *txFlags, *uint64) {
+	fs, flags := newTxFlagSet(name)
+	pool := fs.Uint64("pool", 0, "质押池ID，0为ETH质押池")
+	return fs, flags, pool
+}
+
+// stakeTarget 返回质押交易命令共用的质押合约客户端、交易发送器和质押池
+func (c *cli) stakeTarget(ctx context.Context, poolID uint64) (*stakeclient.Client, *ethtx.Sender, *stakeclient.Pool, error) {
+	client, err := c.stakeClient()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sender, err := c.getSender()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	pool, err := client.Pool(ctx, poolID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return client, sender, pool, nil
+}
+
+// sendStakeCall 按手续费策略和gas估算发送质押交易，等待上链
+// 估算gas时合约 require 失败（例如 "deposit amount is too small"、"withdraw is paused"）会直接报告回滚原因，不会发送注定失败的交易
+func (c *cli) sendStakeCall(ctx context.Context, sender *ethtx.Sender, call stakeclient.Call, pool *stakeclient.Pool, flags *txFlags) (txResult, *types.Receipt, error) {
+	auth, err := c.prepareOpts(ctx, sender, &call.To, call.Value, call.Data)
+	if err != nil {
+		return txResult{}, nil, fmt.Errorf("%s: %w", call.Method, err)
+	}
+	tx, err := sender.Transact(ctx, auth, call.Send)
+	if err = checkSent(tx, err); err != nil {
+		return txResult{}, nil, fmt.Errorf("%s: %w", call.Method, err)
+	}
+	receipt, err := c.waitMined(ctx, tx, flags)
+	if err != nil {
+		return txResult{}, nil, fmt.Errorf("%s: %w", call.Method, err)
+	}
+	id := pool.ID
+	return txResult{Action: call.Method, TxHash: tx.Hash().Hex(), Block: receipt.BlockNumber.Uint64(), GasUsed: receipt.GasUsed, Pool: &id, Symbol: pool.Symbol}, receipt, nil
+}
+
+// emitStakeResult 输出一笔质押交易的结果
+func (c *cli) emitStakeResult(result txResult) error {
+	return c.emit(result, "%s 成功（质押池 %d）\n  数量: %s %s\n  交易: %s\n  区块: %d\n  消耗gas: %d\n",
+		result.Action, *result.Pool, result.Amount, result.Symbol, result.TxHash, result.Block, result.GasUsed)
+}
+
+// stake 质押到质押池：ETH质押池调用 depositETH，代币池授权不足时先授权本次质押的数量再调用 deposit
+func (c *cli) stake(ctx context.Context, args []string) error {
+	fs, flags, poolID := newStakeFlagSet("stake")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("usage: stake [-pool N] <amount>")
+	}
+	client, sender, pool, err := c.stakeTarget(ctx, *poolID)
+	if err != nil {
+		return err
+	}
+	amount, err := pool.ParseAmount(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("amount: %w", err)
+	}
+
+	// OpenZeppelin 的余额和授权不足是自定义错误，估算gas时无法解码为可读原因，这里提前检查
+	balance, err := client.Balance(ctx, pool, sender.Address())
+	if err != nil {
+		return err
+	}
+	if balance.Cmp(amount) < 0 {
+		return fmt.Errorf("insufficient %s balance: have %s, want %s", pool.Symbol, pool.FormatAmount(balance), pool.FormatAmount(amount))
+	}
+	if !pool.IsETH() {
+		allowance, err := client.Allowance(ctx, pool, sender.Address())
+		if err != nil {
+			return err
+		}
+		if allowance.Cmp(amount) < 0 {
+			// 只授权本次质押的数量，不做无限授权
+			approve, err := client.Approve(pool, amount)
+			if err != nil {
+				return err
+			}
+			result, _, err := c.sendStakeCall(ctx, sender, approve, pool, flags)
+			if err != nil {
+				return err
+			}
+			result.Amount = pool.FormatAmount(amount)
+			if err := c.emitStakeResult(result); err != nil {
+				return err
+			}
+		}
+	}
+
+	call, err := client.Deposit(pool, amount)
+	if err != nil {
+		return err
+	}
+	result, _, err := c.sendStakeCall(ctx, sender, call, pool, flags)
+	if err != nil {
+		return err
+	}
+	result.Amount = pool.FormatAmount(amount)
+	return c.emitStakeResult(result)
+}
+
+// unstake 申请解除质押，数量在锁定期（unstake_locked_blocks 个区块）后才能通过 withdraw 提取
+func (c *cli) unstake(ctx context.Context, args []string) error {
+	fs, flags, poolID := newStakeFlagSet("unstake")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("usage: unstake [-pool N] <amount>")
+	}
+	client, sender, pool, err := c.stakeTarget(ctx, *poolID)
+	if err != nil {
+		return err
+	}
+	amount, err := pool.ParseAmount(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("amount: %w", err)
+	}
+
+	call, err := client.Unstake(pool, amount)
+	if err != nil {
+		return err
+	}
+	result, _, err := c.sendStakeCall(ctx, sender, call, pool, flags)
+	if err != nil {
+		return err
+	}
+	result.Amount = pool.FormatAmount(amount)
+	if !c.json {
+		log.Printf("%s %s 将在 %d 个区块后可以提取", result.Amount, pool.Symbol, pool.UnstakeLockedBlocks)
+	}
+	return c.emitStakeResult(result)
+}
+
+// withdraw 提取质押池中所有已过锁定期的解除质押请求
+func (c *cli) withdraw(ctx context.Context, args []string) error {
+	fs, flags, poolID := newStakeFlagSet("withdraw")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	client, sender, pool, err := c.stakeTarget(ctx, *poolID)
+	if err != nil {
+		return err
+	}
+
+	// 没有已解锁的请求时合约不会回滚，提前检查避免白白支付手续费
+	position, err := client.Position(ctx, pool, sender.Address())
+	if err != nil {
+		return err
+	}
+	if position.Unlockable.Sign() == 0 {
+		if position.Requested.Sign() == 0 {
+			return fmt.Errorf("nothing to withdraw from %s: no unstake requests", pool)
+		}
+		return fmt.Errorf("nothing to withdraw from %s yet: %s %s is still locked", pool, pool.FormatAmount(position.Locked()), pool.Symbol)
+	}
+
+	call, err := client.Withdraw(pool)
+	if err != nil {
+		return err
+	}
+	result, receipt, err := c.sendStakeCall(ctx, sender, call, pool, flags)
+	if err != nil {
+		return err
+	}
+	result.Amount = pool.FormatAmount(client.Withdrawn(receipt))
+	return c.emitStakeResult(result)
+}
+
+// claim 领取质押池中的MetaNode奖励
+func (c *cli) claim(ctx context.Context, args []string) error {
+	fs, flags, poolID := newStakeFlagSet("claim")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	client, sender, pool, err := c.stakeTarget(ctx, *poolID)
+	if err != nil {
+		return err
+	}
+	rewardToken, err := client.RewardToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 没有奖励时合约同样不会回滚
+	position, err := client.Position(ctx, pool, sender.Address())
+	if err != nil {
+		return err
+	}
+	if position.PendingReward.Sign() == 0 {
+		return fmt.Errorf("no %s reward to claim from %s", rewardToken.Symbol, pool)
+	}
+
+	call, err := client.Claim(pool)
+	if err != nil {
+		return err
+	}
+	result, receipt, err := c.sendStakeCall(ctx, sender, call, pool, flags)
+	if err != nil {
+		return err
+	}
+	result.Amount, result.Symbol = ethtx.FormatUnits(client.Claimed(receipt), rewardToken.Decimals), rewardToken.Symbol
+	return c.emitStakeResult(result)
+}
+
+// positions 查询账户在所有质押池中的质押数量、待领取奖励和解除质押请求，默认查询签名账户
+func (c *cli) positions(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("positions", flag.ContinueOnError)
+	accountFlag := fs.String("account", "", "查询的账户地址，默认为签名账户")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	client, err := c.stakeClient()
+	if err != nil {
+		return err
+	}
+
+	var account common.Address
+	if *accountFlag != "" {
+		if !common.IsHexAddress(*accountFlag) {
+			return fmt.Errorf("invalid account address %q", *accountFlag)
+		}
+		account = common.HexToAddress(*accountFlag)
+	} else {
+		sender, err := c.getSender()
+		if err != nil {
+			return err
+		}
+		account = sender.Address()
+	}
+
+	report, err := client.Report(ctx, account)
+	if err != nil {
+		return err
+	}
+	out := newStakeReportOutput(client.Address, report)
+	if c.json {
+		return json.NewEncoder(c.out).Encode(out)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "账户 %s 在 MetaNodeStake %s 的持仓（区块 %d）\n", out.Account, out.Contract, out.Block)
+	for i, pool := range out.Pools {
+		fmt.Fprintf(&b, "  质押池 %d (%s):", pool.Pool, pool.Symbol)
+		if report.Positions[i].Empty() {
+			fmt.Fprintf(&b, " 无持仓\n")
+			continue
+		}
+		fmt.Fprintf(&b, " 质押 %s %s，待领取奖励 %s %s\n", pool.Staked, pool.Symbol, pool.PendingReward, out.RewardSymbol)
+		if report.Positions[i].Requested.Sign() > 0 {
+			fmt.Fprintf(&b, "    解除质押 %s %s：可提取 %s，锁定中 %s（锁定期 %d 个区块）\n",
+				pool.Unstaking, pool.Symbol, pool.Unlockable, pool.Locked, pool.UnstakeLockedBlocks)
+		}
+	}
+	fmt.Fprintf(&b, "待领取奖励合计: %s %s\n", out.TotalPendingReward, out.RewardSymbol)
+	_, err = io.WriteString(c.out, b.String())
+	return err
+}
+
+// stakePositionOutput 一个质押池持仓的输出格式，数量按代币精度格式化
+type stakePositionOutput struct {
+	Pool                uint64 `json:"pool"`
+	Token               string `json:"token"` // ETH质押池为零地址
+	Symbol              string `json:"symbol,omitempty"`
+	Staked              string `json:"staked"`
+	PendingReward       string `json:"pending_reward"` // MetaNode
+	Unstaking           string `json:"unstaking"`      // 所有未提取的解除质押请求
+	Unlockable          string `json:"unlockable"`     // 其中可以 withdraw 的数量
+	Locked              string `json:"locked"`
+	UnstakeLockedBlocks uint64 `json:"unstake_locked_blocks"`
+}
+
+// stakeReportOutput positions 命令的输出格式
+type stakeReportOutput struct {
+	Contract           string                `json:"contract"`
+	Account            string                `json:"account"`
+	Block              uint64                `json:"block"`
+	RewardToken        string                `json:"reward_token"`
+	RewardSymbol       string                `json:"reward_symbol,omitempty"`
+	TotalPendingReward string                `json:"total_pending_reward"`
+	Pools              []stakePositionOutput `json:"pools"`
+}
+
+func newStakeReportOutput(contract common.Address, report *stakeclient.Report) stakeReportOutput {
+	out := stakeReportOutput{
+		Contract:           contract.Hex(),
+		Account:            report.Account.Hex(),
+		Block:              report.Block,
+		RewardToken:        report.RewardToken.Address.Hex(),
+		RewardSymbol:       report.RewardToken.Symbol,
+		TotalPendingReward: ethtx.FormatUnits(report.TotalPendingReward, report.RewardToken.Decimals),
+		Pools:              []stakePositionOutput{},
+	}
+	for _, position := range report.Positions {
+		pool := position.Pool
+		out.Pools = append(out.Pools, stakePositionOutput{
+			Pool:                pool.ID,
+			Token:               pool.Token.Hex(),
+			Symbol:              pool.Symbol,
+			Staked:              pool.FormatAmount(position.Staked),
+			PendingReward:       ethtx.FormatUnits(position.PendingReward, report.RewardToken.Decimals),
+			Unstaking:           pool.FormatAmount(position.Requested),
+			Unlockable:          pool.FormatAmount(position.Unlockable),
+			Locked:              pool.FormatAmount(position.Locked()),
+			UnstakeLockedBlocks: pool.UnstakeLockedBlocks,
+		})
+	}
+	return out
+}
+
+// commandNames 返回所有子命令名，用于错误提示
+func commandNames() string {
+	names := make([]string, len(commands))
+	for i, cmd := range commands {
+		names[i] = cmd.name
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"ethtx"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultConfigPath 默认的配置文件路径
+const defaultConfigPath = "config.yaml"
+
+// Config Counter客户端的配置，从YAML文件加载，环境变量可以覆盖文件中的值
+type Config struct {
+	Network   NetworkConfig      `yaml:"network"`
+	Contracts ContractsConfig    `yaml:"contracts"`
+	Events    EventsConfig       `yaml:"events"`
+	Signer    ethtx.SignerConfig `yaml:"signer"`
+	Fees      ethtx.FeeConfig    `yaml:"fees"`
+	Gas       ethtx.GasConfig    `yaml:"gas"`
+}
+
+// NetworkConfig 节点端点和链ID
+type NetworkConfig struct {
+	RPCURL       string   `yaml:"rpc_url"`           // HTTP端点，用于交易和合约调用（COUNTER_RPC_URL）
+	FallbackURLs []string `yaml:"rpc_fallback_urls"` // 备用HTTP端点，rpc_url 限流、5xx或超时时依次使用（COUNTER_RPC_FALLBACK_URLS，逗号分隔）
+	WSURL        string   `yaml:"ws_url"`            // WebSocket端点，用于事件订阅，为空时使用轮询（COUNTER_WS_URL）
+	ChainID      uint64   `yaml:"chain_id"`          // 链ID，启动时与节点返回的链ID比对（COUNTER_CHAIN_ID）
+}
+
+// ContractsConfig 合约地址
+type ContractsConfig struct {
+	Counter        string `yaml:"counter"`         // Counter合约地址（COUNTER_CONTRACT_ADDRESS）
+	AuctionFactory string `yaml:"auction_factory"` // NFT拍卖工厂合约地址，auctions 命令需要（COUNTER_AUCTION_FACTORY）
+	MetaNodeStake  string `yaml:"metanode_stake"`  // MetaNodeStake 质押合约（代理合约）地址，质押命令需要（COUNTER_METANODE_STAKE）
+}
+
+// EventsConfig 事件监听配置
+type EventsConfig struct {
+	Confirmations uint64 `yaml:"confirmations"`   // 事件处理前需要的区块确认数（COUNTER_CONFIRMATIONS）
+	FinalityDepth uint64 `yaml:"finality_depth"`  // 最终确认深度（COUNTER_FINALITY_DEPTH）
+	StartBlock    uint64 `yaml:"start_block"`     // 没有检查点时开始扫描的区块号（COUNTER_START_BLOCK）
+	StoreBackend  string `yaml:"store_backend"`   // 事件存储后端：log 或 json（COUNTER_STORE_BACKEND）
+	StorePath     string `yaml:"store_path"`      // 事件存储文件路径（COUNTER_STORE_PATH）
+	AuctionStore  string `yaml:"auction_store"`   // 拍卖索引的事件存储文件路径，与Counter事件分开记录检查点（COUNTER_AUCTION_STORE）
+	LegacyStore   string `yaml:"legacy_store"`    // 启动时导入的旧版JSON事件存储文件路径，为空时不导入（COUNTER_LEGACY_STORE）
+	MetricsAddr   string `yaml:"metrics_addr"`    // 指标服务监听地址，为空时不启动（COUNTER_METRICS_ADDR）
+	MaxHealthyLag uint64 `yaml:"max_healthy_lag"` // /healthz 允许的最大区块落后数（COUNTER_MAX_HEALTHY_LAG）
+}
+
+// defaultConfig 返回默认配置，未在文件和环境变量中设置的字段使用这些值
+func defaultConfig() *Config {
+	return &Config{
+		Events: EventsConfig{
+			Confirmations: 3,
+			FinalityDepth: defaultFinalityDepth,
+			StoreBackend:  StoreBackendLog,
+			StorePath:     "event_store.log",
+			AuctionStore:  "auction_store.log",
+			LegacyStore:   "event_store.json",
+			MaxHealthyLag: defaultMaxHealthyLag,
+		},
+		Signer: ethtx.DefaultSignerConfig(),
+		Fees:   ethtx.DefaultFeeConfig(),
+		Gas:    defaultGasConfig(),
+	}
+}
+
+// defaultGasConfig 返回Counter交易的gas估算配置，节点无法估算时回退到 300000
+func defaultGasConfig() ethtx.GasConfig {
+	gas := ethtx.DefaultGasConfig()
+	gas.Max = 1_000_000
+	gas.FallbackLimit = 300_000
+	return gas
+}
+
+// LoadConfig 加载配置：默认值 <- 配置文件 <- 环境变量，最后校验配置
+// path 为默认路径且文件不存在时只使用默认值和环境变量；URL中的 ${VAR} 会被替换为环境变量的值
+func LoadConfig(path string) (*Config, error) {
+	cfg := defaultConfig()
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist) && path == defaultConfigPath:
+	case err != nil:
+		return nil, fmt.Errorf("read config %s: %w", path, err)
+	default:
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(cfg); err != nil {
+			return nil, fmt.Errorf("parse config %s: %w", path, err)
+		}
+	}
+
+	if err := cfg.applyEnv(); err != nil {
+		return nil, err
+	}
+	fields := []*string{&cfg.Network.RPCURL, &cfg.Network.WSURL}
+	for i := range cfg.Network.FallbackURLs {
+		fields = append(fields, &cfg.Network.FallbackURLs[i])
+	}
+	for _, field := range fields {
+		expanded, err := expandEnv(*field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid config: %w", err)
+		}
+		*field = expanded
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	return cfg, nil
+}
+
+// applyEnv 用环境变量覆盖配置
+func (c *Config) applyEnv() error {
+	stringFields := map[string]*string{
+		"COUNTER_RPC_URL":              &c.Network.RPCURL,
+		"COUNTER_WS_URL":               &c.Network.WSURL,
+		"COUNTER_CONTRACT_ADDRESS":     &c.Contracts.Counter,
+		"COUNTER_AUCTION_FACTORY":      &c.Contracts.AuctionFactory,
+		"COUNTER_METANODE_STAKE":       &c.Contracts.MetaNodeStake,
+		"COUNTER_AUCTION_STORE":        &c.Events.AuctionStore,
+		"COUNTER_LEGACY_STORE":         &c.Events.LegacyStore,
+		"COUNTER_STORE_BACKEND":        &c.Events.StoreBackend,
+		"COUNTER_STORE_PATH":           &c.Events.StorePath,
+		"COUNTER_METRICS_ADDR":         &c.Events.MetricsAddr,
+		"COUNTER_SIGNER_TYPE":          &c.Signer.Type,
+		"COUNTER_PRIVATE_KEY_ENV":      &c.Signer.PrivateKeyEnv,
+		"COUNTER_KEYSTORE_PATH":        &c.Signer.KeystorePath,
+		"COUNTER_PASSPHRASE_ENV":       &c.Signer.PassphraseEnv,
+		"COUNTER_MNEMONIC_ENV":         &c.Signer.MnemonicEnv,
+		"COUNTER_DERIVATION_PATH":      &c.Signer.DerivationPath,
+		"COUNTER_EXTERNAL_SIGNER_URL":  &c.Signer.ExternalURL,
+		"COUNTER_EXTERNAL_SIGNER_ADDR": &c.Signer.Address,
+		"COUNTER_FEE_STRATEGY":         &c.Fees.Strategy,
+		"COUNTER_TIP_CAP_GWEI":         &c.Fees.TipCapGwei,
+		"COUNTER_FEE_CAP_GWEI":         &c.Fees.FeeCapGwei,
+	}
+	for name, field := range stringFields {
+		if value, ok := os.LookupEnv(name); ok {
+			*field = value
+		}
+	}
+
+	if value, ok := os.LookupEnv("COUNTER_RPC_FALLBACK_URLS"); ok {
+		c.Network.FallbackURLs = nil
+		for _, url := range strings.Split(value, ",") {
+			if url = strings.TrimSpace(url); url != "" {
+				c.Network.FallbackURLs = append(c.Network.FallbackURLs, url)
+			}
+		}
+	}
+
+	numberFields := map[string]*uint64{
+		"COUNTER_CHAIN_ID":        &c.Network.ChainID,
+		"COUNTER_CONFIRMATIONS":   &c.Events.Confirmations,
+		"COUNTER_FINALITY_DEPTH":  &c.Events.FinalityDepth,
+		"COUNTER_START_BLOCK":     &c.Events.StartBlock,
+		"COUNTER_MAX_HEALTHY_LAG": &c.Events.MaxHealthyLag,
+		"COUNTER_GAS_MAX":         &c.Gas.Max,
+		"COUNTER_GAS_FALLBACK":    &c.Gas.FallbackLimit,
+	}
+	for name, field := range numberFields {
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("environment variable %s: %q is not a non-negative integer", name, value)
+		}
+		*field = n
+	}
+
+	if value, ok := os.LookupEnv("COUNTER_GAS_MULTIPLIER"); ok {
+		multiplier, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("environment variable COUNTER_GAS_MULTIPLIER: %q is not a number", value)
+		}
+		c.Gas.Multiplier = multiplier
+	}
+	return nil
+}
+
+// Validate 校验配置，返回所有错误
+func (c *Config) Validate() error {
+	var errs []error
+
+	if err := validateURL(c.Network.RPCURL, "http", "https", "ws", "wss"); err != nil {
+		errs = append(errs, fmt.Errorf("network.rpc_url: %w", err))
+	}
+	for i, url := range c.Network.FallbackURLs {
+		if err := validateURL(url, "http", "https", "ws", "wss"); err != nil {
+			errs = append(errs, fmt.Errorf("network.rpc_fallback_urls[%d]: %w", i, err))
+		}
+	}
+	if c.Network.WSURL != "" {
+		if err := validateURL(c.Network.WSURL, "ws", "wss"); err != nil {
+			errs = append(errs, fmt.Errorf("network.ws_url: %w", err))
+		}
+	}
+	if c.Network.ChainID == 0 {
+		errs = append(errs, errors.New("network.chain_id: required"))
+	}
+
+	// 合约地址可以为空（deploy 命令部署新合约），需要合约的命令会单独检查
+	if c.Contracts.Counter != "" && !common.IsHexAddress(c.Contracts.Counter) {
+		errs = append(errs, fmt.Errorf("contracts.counter: %q is not a valid address", c.Contracts.Counter))
+	}
+	if c.Contracts.AuctionFactory != "" && !common.IsHexAddress(c.Contracts.AuctionFactory) {
+		errs = append(errs, fmt.Errorf("contracts.auction_factory: %q is not a valid address", c.Contracts.AuctionFactory))
+	}
+	if c.Contracts.MetaNodeStake != "" && !common.IsHexAddress(c.Contracts.MetaNodeStake) {
+		errs = append(errs, fmt.Errorf("contracts.metanode_stake: %q is not a valid address", c.Contracts.MetaNodeStake))
+	}
+
+	if c.Events.FinalityDepth < c.Events.Confirmations {
+		errs = append(errs, fmt.Errorf("events.finality_depth: %d is less than confirmations %d", c.Events.FinalityDepth, c.Events.Confirmations))
+	}
+	if c.Events.StoreBackend != StoreBackendLog && c.Events.StoreBackend != StoreBackendJSON {
+		errs = append(errs, fmt.Errorf("events.store_backend: unknown backend %q (want %q or %q)", c.Events.StoreBackend, StoreBackendLog, StoreBackendJSON))
+	}
+	if c.Events.StorePath == "" {
+		errs = append(errs, errors.New("events.store_path: required"))
+	}
+	if c.Events.AuctionStore == "" {
+		errs = append(errs, errors.New("events.auction_store: required"))
+	} else if c.Events.AuctionStore == c.Events.StorePath {
+		errs = append(errs, errors.New("events.auction_store: must differ from events.store_path"))
+	}
+	if c.Events.LegacyStore != "" && c.Events.LegacyStore == c.Events.StorePath {
+		errs = append(errs, errors.New("events.legacy_store: must differ from events.store_path"))
+	}
+
+	if err := c.Signer.Validate(); err != nil {
+		errs = append(errs, prefixErrors("signer.", err))
+	}
+	if err := c.Fees.Validate(); err != nil {
+		errs = append(errs, prefixErrors("fees.", err))
+	}
+	if err := c.Gas.Validate(); err != nil {
+		errs = append(errs, prefixErrors("gas.", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// expandEnv 将字符串中的 ${VAR} 替换为环境变量的值，引用了未设置的环境变量时返回错误，避免使用不完整的URL
+func expandEnv(s string) (string, error) {
+	var missing []string
+	expanded := os.Expand(s, func(name string) string {
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("environment variable %s is not set", strings.Join(missing, ", "))
+	}
+	return expanded, nil
+}
+
+// validateURL 校验URL不为空、能够解析且协议在允许的列表中
+func validateURL(raw string, schemes ...string) error {
+	if raw == "" {
+		return errors.New("required")
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	for _, scheme := range schemes {
+		if u.Scheme == scheme {
+			return nil
+		}
+	}
+	return fmt.Errorf("scheme %q not supported (want one of %s)", u.Scheme, strings.Join(schemes, ", "))
+}
+
+// RPCURLs 返回按优先级排列的HTTP端点：rpc_url 和备用端点
+func (c *Config) RPCURLs() []string {
+	return append([]string{c.Network.RPCURL}, c.Network.FallbackURLs...)
+}
+
+// ContractAddress 返回Counter合约地址
+func (c *Config) ContractAddress() common.Address {
+	return common.HexToAddress(c.Contracts.Counter)
+}
+
+// AuctionFactoryAddress 返回配置的NFT拍卖工厂合约地址
+func (c *Config) AuctionFactoryAddress() common.Address {
+	return common.HexToAddress(c.Contracts.AuctionFactory)
+}
+
+// MetaNodeStakeAddress 返回配置的MetaNodeStake质押合约地址
+func (c *Config) MetaNodeStakeAddress() common.Address {
+	return common.HexToAddress(c.Contracts.MetaNodeStake)
+}
+
+// prefixErrors 为 errors.Join 合并的每个错误加上配置节的前缀
+func prefixErrors(prefix string, err error) error {
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return fmt.Errorf("%s%w", prefix, err)
+	}
+	var errs []error
+	for _, e := range joined.Unwrap() {
+		errs = append(errs, fmt.Errorf("%s%w", prefix, e))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"ethtx"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const testConfigYAML = `
+network:
+  rpc_url: https://sepolia.infura.io/v3/${TEST_INFURA_KEY}
+  ws_url: wss://sepolia.infura.io/ws/v3/${TEST_INFURA_KEY}
+  chain_id: 11155111
+contracts:
+  counter: "0x42c3e45FF2E9AF12F21f5FEF6F7B874aDB9eBeBc"
+events:
+  confirmations: 3
+`
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfigWithEnvOverrides(t *testing.T) {
+	t.Setenv("TEST_INFURA_KEY", "abc123")
+	t.Setenv("COUNTER_CONFIRMATIONS", "6")
+	t.Setenv("COUNTER_METRICS_ADDR", ":9200")
+
+	cfg, err := LoadConfig(writeConfig(t, testConfigYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Network.RPCURL != "https://sepolia.infura.io/v3/abc123" {
+		t.Errorf("rpc_url = %q", cfg.Network.RPCURL)
+	}
+	if cfg.Events.Confirmations != 6 {
+		t.Errorf("confirmations = %d, want env override 6", cfg.Events.Confirmations)
+	}
+	if cfg.Events.MetricsAddr != ":9200" {
+		t.Errorf("metrics_addr = %q", cfg.Events.MetricsAddr)
+	}
+	// 未设置的字段使用默认值
+	if cfg.Events.StorePath != "event_store.log" || cfg.Events.LegacyStore != "event_store.json" || cfg.Signer.PrivateKeyEnv != "PRIVATE_KEY" {
+		t.Errorf("defaults not applied: %+v %+v", cfg.Events, cfg.Signer)
+	}
+
+	// legacy_store 设为空时不导入旧版存储
+	cfg, err = LoadConfig(writeConfig(t, testConfigYAML+"  legacy_store: \"\"\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Events.LegacyStore != "" {
+		t.Errorf("legacy_store = %q, want empty", cfg.Events.LegacyStore)
+	}
+}
+
+func TestLoadConfigFallbackURLs(t *testing.T) {
+	t.Setenv("TEST_INFURA_KEY", "abc123")
+	t.Setenv("TEST_ALCHEMY_KEY", "def456")
+
+	yaml := strings.Replace(testConfigYAML, "  chain_id:", "  rpc_fallback_urls:\n    - https://eth-sepolia.g.alchemy.com/v2/${TEST_ALCHEMY_KEY}\n  chain_id:", 1)
+	cfg, err := LoadConfig(writeConfig(t, yaml))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "https://sepolia.infura.io/v3/abc123,https://eth-sepolia.g.alchemy.com/v2/def456"
+	if got := strings.Join(cfg.RPCURLs(), ","); got != want {
+		t.Errorf("RPCURLs = %s, want %s", got, want)
+	}
+
+	// 环境变量覆盖配置文件中的备用端点
+	t.Setenv("COUNTER_RPC_FALLBACK_URLS", "https://rpc.sepolia.org, ftp://bad.example.org")
+	if _, err := LoadConfig(writeConfig(t, yaml)); err == nil || !strings.Contains(err.Error(), "network.rpc_fallback_urls[1]") {
+		t.Errorf("err = %v, want invalid fallback URL", err)
+	}
+}
+
+func TestLoadConfigReportsAllErrors(t *testing.T) {
+	t.Setenv("COUNTER_CHAIN_ID", "0")
+	t.Setenv("COUNTER_CONTRACT_ADDRESS", "0x1234")
+	t.Setenv("COUNTER_AUCTION_FACTORY", "factory")
+	t.Setenv("COUNTER_METANODE_STAKE", "0xstake")
+	t.Setenv("COUNTER_AUCTION_STORE", "event_store.log")
+	t.Setenv("COUNTER_LEGACY_STORE", "event_store.log")
+	t.Setenv("COUNTER_CONFIRMATIONS", "100")
+	t.Setenv("COUNTER_SIGNER_TYPE", "plaintext")
+	t.Setenv("COUNTER_FEE_STRATEGY", "fixed")
+	t.Setenv("COUNTER_GAS_MULTIPLIER", "0.5")
+	t.Setenv("TEST_INFURA_KEY", "abc123")
+
+	_, err := LoadConfig(writeConfig(t, testConfigYAML))
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	for _, want := range []string{"network.chain_id", "contracts.counter", "contracts.auction_factory", "contracts.metanode_stake", "events.auction_store", "events.legacy_store", "events.finality_depth", "signer.type", "fees.tip_cap_gwei", "fees.fee_cap_gwei", "gas.multiplier"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %s", err, want)
+		}
+	}
+}
+
+func TestLoadConfigRejectsUnsetVariablesAndUnknownFields(t *testing.T) {
+	t.Setenv("TEST_INFURA_KEY", "")
+	os.Unsetenv("TEST_INFURA_KEY")
+	if _, err := LoadConfig(writeConfig(t, testConfigYAML)); err == nil || !strings.Contains(err.Error(), "TEST_INFURA_KEY") {
+		t.Errorf("unset variable: err = %v", err)
+	}
+
+	if _, err := LoadConfig(writeConfig(t, testConfigYAML+"  confirmation: 3\n")); err == nil || !strings.Contains(err.Error(), "confirmation") {
+		t.Errorf("unknown field: err = %v", err)
+	}
+
+	t.Setenv("TEST_INFURA_KEY", "abc123")
+	t.Setenv("COUNTER_START_BLOCK", "latest")
+	if _, err := LoadConfig(writeConfig(t, testConfigYAML)); err == nil || !strings.Contains(err.Error(), "COUNTER_START_BLOCK") {
+		t.Errorf("invalid number: err = %v", err)
+	}
+}
+
+func TestLoadConfigSignerFromEnv(t *testing.T) {
+	t.Setenv("TEST_INFURA_KEY", "abc123")
+	t.Setenv("COUNTER_SIGNER_TYPE", "mnemonic")
+	t.Setenv("COUNTER_MNEMONIC_ENV", "TEST_MNEMONIC")
+	t.Setenv("TEST_MNEMONIC", "test test test test test test test test test test test junk")
+
+	cfg, err := LoadConfig(writeConfig(t, testConfigYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ethtx.NewSigner(cfg.Signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer.Address() != common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266") {
+		t.Errorf("signer address = %s", signer.Address().Hex())
+	}
+
+	t.Setenv("COUNTER_SIGNER_TYPE", "keystore")
+	if _, err := LoadConfig(writeConfig(t, testConfigYAML)); err == nil || !strings.Contains(err.Error(), "signer.keystore_path") {
+		t.Errorf("keystore without path: err = %v", err)
+	}
+}
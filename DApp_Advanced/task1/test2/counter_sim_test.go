@@ -0,0 +1,548 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	Counter "counter/counter"
+	"ethtx"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+)
+
+// simChainID 模拟后端固定使用的链ID
+var simChainID = big.NewInt(1337)
+
+// simChain 部署了Counter合约的模拟链
+type simChain struct {
+	backend  *simulated.Backend
+	client   simulated.Client
+	key      *ecdsa.PrivateKey
+	auth     *bind.TransactOpts
+	contract common.Address
+	counter  *Counter.Counter
+}
+
+// newSimChain 创建模拟链并通过 DeployCounter 部署Counter合约
+func newSimChain(t *testing.T) *simChain {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		from: {Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(1e18))},
+	})
+	t.Cleanup(func() { backend.Close() })
+
+	auth, err := bind.NewKeyedTransactorWithChainID(key, simChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain := &simChain{backend: backend, client: backend.Client(), key: key, auth: auth}
+
+	address, tx, counter, err := Counter.DeployCounter(auth, chain.client)
+	if err != nil {
+		t.Fatalf("deploy Counter: %v", err)
+	}
+	backend.Commit()
+	chain.requireSuccess(t, tx)
+	chain.contract, chain.counter = address, counter
+	return chain
+}
+
+// transact 发送一笔Counter交易并出块
+func (c *simChain) transact(t *testing.T, method func(*bind.TransactOpts) (*types.Transaction, error)) *types.Transaction {
+	t.Helper()
+	tx, err := method(c.auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.backend.Commit()
+	c.requireSuccess(t, tx)
+	return tx
+}
+
+func (c *simChain) requireSuccess(t *testing.T, tx *types.Transaction) *types.Receipt {
+	t.Helper()
+	receipt, err := c.client.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		t.Fatalf("receipt of %s: %v", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("transaction %s failed", tx.Hash().Hex())
+	}
+	return receipt
+}
+
+func (c *simChain) count(t *testing.T) uint64 {
+	t.Helper()
+	count, err := c.counter.GetCount(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return count.Uint64()
+}
+
+// countingCallback 按事件（交易、日志序号、区块）统计处理和回滚次数，可以让指定次数的处理失败
+type countingCallback struct {
+	mu         sync.Mutex
+	processed  map[string]int
+	rolledBack map[string]int
+	order      []string // 成功处理的 action:newCount，工作池并发处理，顺序不固定
+	failures   map[string]int
+	failFirst  func(event *Counter.CounterChangeCount) int // 返回该事件前几次处理需要失败
+	changed    chan struct{}
+}
+
+func newCountingCallback() *countingCallback {
+	return &countingCallback{
+		processed:  make(map[string]int),
+		rolledBack: make(map[string]int),
+		failures:   make(map[string]int),
+		changed:    make(chan struct{}, 1),
+	}
+}
+
+func eventID(event *Counter.CounterChangeCount) string {
+	return fmt.Sprintf("%s-%d-%s", event.Raw.TxHash.Hex(), event.Raw.Index, event.Raw.BlockHash.Hex())
+}
+
+func (c *countingCallback) handle(event *Counter.CounterChangeCount) error {
+	c.mu.Lock()
+	defer c.notify()
+	defer c.mu.Unlock()
+
+	id := eventID(event)
+	if c.failFirst != nil && c.failures[id] < c.failFirst(event) {
+		c.failures[id]++
+		return errors.New("simulated callback failure")
+	}
+	c.processed[id]++
+	c.order = append(c.order, fmt.Sprintf("%s:%s", event.Action, event.NewCount))
+	return nil
+}
+
+func (c *countingCallback) rollback(event *Counter.CounterChangeCount) error {
+	c.mu.Lock()
+	defer c.notify()
+	defer c.mu.Unlock()
+
+	c.rolledBack[eventID(event)]++
+	return nil
+}
+
+// handled 返回排序后的成功处理记录
+func (c *countingCallback) handled() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	order := append([]string(nil), c.order...)
+	sort.Strings(order)
+	return strings.Join(order, " ")
+}
+
+func (c *countingCallback) notify() {
+	select {
+	case c.changed <- struct{}{}:
+	default:
+	}
+}
+
+// waitFor 等待 cond 成立，cond 在持有锁时调用
+func (c *countingCallback) waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.After(10 * time.Second)
+	for {
+		c.mu.Lock()
+		ok := cond()
+		c.mu.Unlock()
+		if ok {
+			return
+		}
+		select {
+		case <-c.changed:
+		case <-time.After(20 * time.Millisecond):
+		case <-deadline:
+			t.Fatalf("timed out waiting for %s", what)
+		}
+	}
+}
+
+// assertExactlyOnce 检查每个事件最多处理一次，返回处理过的事件数
+func (c *countingCallback) assertExactlyOnce(t *testing.T) int {
+	t.Helper()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for id, n := range c.processed {
+		if n != 1 {
+			t.Errorf("event %s processed %d times", id, n)
+		}
+	}
+	for id, n := range c.rolledBack {
+		if n != 1 {
+			t.Errorf("event %s rolled back %d times", id, n)
+		}
+	}
+	return len(c.processed)
+}
+
+// startSimHandler 创建并启动轮询模拟链的事件处理器，测试结束时停止
+func startSimHandler(t *testing.T, chain *simChain, store EventStore, callback *countingCallback, confirmations uint64) *EventHandler {
+	t.Helper()
+	handler, err := NewEventHandler(chain.client, store, Counter.CounterMetaData.ABI,
+		[]common.Address{chain.contract}, []string{"changeCount"}, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := HandleTyped(handler, "changeCount", callback.handle, callback.rollback); err != nil {
+		t.Fatal(err)
+	}
+	handler.SetPollInterval(20 * time.Millisecond)
+	handler.SetConfirmations(confirmations)
+	handler.SetRetryPolicy(5, 10*time.Millisecond, 40*time.Millisecond)
+	if err := handler.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { handler.Stop() })
+	return handler
+}
+
+func openSimStore(t *testing.T, path string) EventStore {
+	t.Helper()
+	store, err := OpenEventStore(StoreBackendLog, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+func TestCounterBindingsOnSimulatedBackend(t *testing.T) {
+	chain := newSimChain(t)
+	callback := newCountingCallback()
+	store := openSimStore(t, filepath.Join(t.TempDir(), "events.log"))
+	defer store.Close()
+	startSimHandler(t, chain, store, callback, 0)
+
+	for _, step := range []struct {
+		method func(*bind.TransactOpts) (*types.Transaction, error)
+		want   uint64
+	}{
+		{chain.counter.Increment, 1},
+		{chain.counter.Increment, 2},
+		{chain.counter.Increment, 3},
+		{chain.counter.Decrement, 2},
+		{chain.counter.Reset, 0},
+	} {
+		chain.transact(t, step.method)
+		if got := chain.count(t); got != step.want {
+			t.Fatalf("count = %d, want %d", got, step.want)
+		}
+	}
+
+	// 计数为0时 decrement 的 require 失败，估算gas阶段就能拿到回滚原因
+	_, err := chain.counter.Decrement(chain.auth)
+	revert, ok := ethtx.AsRevert(err)
+	if !ok || revert.Reason != "Count cannot be negative" {
+		t.Errorf("decrement at zero: err = %v, want revert reason from require", err)
+	}
+
+	callback.waitFor(t, "5 events", func() bool { return len(callback.order) == 5 })
+	want := "decrement:2 increment:1 increment:2 increment:3 reset:0"
+	if got := callback.handled(); got != want {
+		t.Errorf("processed events = %q, want %q", got, want)
+	}
+	if n := callback.assertExactlyOnce(t); n != 5 {
+		t.Errorf("processed %d distinct events, want 5", n)
+	}
+}
+
+func TestEventHandlerExactlyOnceAcrossRestarts(t *testing.T) {
+	chain := newSimChain(t)
+	callback := newCountingCallback()
+	path := filepath.Join(t.TempDir(), "events.log")
+
+	// 第一次运行：处理两个事件后停止
+	store := openSimStore(t, path)
+	handler := startSimHandler(t, chain, store, callback, 0)
+	chain.transact(t, chain.counter.Increment)
+	chain.transact(t, chain.counter.Increment)
+	callback.waitFor(t, "2 events before restart", func() bool { return len(callback.processed) == 2 })
+	waitProcessed(t, store, 2)
+	if err := handler.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// 停止期间产生的事件在重启后从检查点补齐
+	chain.transact(t, chain.counter.Increment)
+	chain.transact(t, chain.counter.Decrement)
+
+	store = openSimStore(t, path)
+	defer store.Close()
+	startSimHandler(t, chain, store, callback, 0)
+	callback.waitFor(t, "4 events after restart", func() bool { return len(callback.processed) == 4 })
+
+	// 再等几个轮询周期，确认重启前的事件没有被重复处理
+	time.Sleep(200 * time.Millisecond)
+	if n := callback.assertExactlyOnce(t); n != 4 {
+		t.Errorf("processed %d distinct events, want 4", n)
+	}
+	want := "decrement:2 increment:1 increment:2 increment:3"
+	if got := callback.handled(); got != want {
+		t.Errorf("processed events = %q, want %q", got, want)
+	}
+}
+
+func TestEventHandlerRetriesFailedCallbacks(t *testing.T) {
+	chain := newSimChain(t)
+	callback := newCountingCallback()
+	// 第二个事件的前两次处理失败
+	callback.failFirst = func(event *Counter.CounterChangeCount) int {
+		if event.NewCount.Int64() == 2 {
+			return 2
+		}
+		return 0
+	}
+	store := openSimStore(t, filepath.Join(t.TempDir(), "events.log"))
+	defer store.Close()
+	startSimHandler(t, chain, store, callback, 0)
+
+	for i := 0; i < 3; i++ {
+		chain.transact(t, chain.counter.Increment)
+	}
+	callback.waitFor(t, "3 events", func() bool { return len(callback.processed) == 3 })
+	waitProcessed(t, store, 3)
+
+	if n := callback.assertExactlyOnce(t); n != 3 {
+		t.Errorf("processed %d distinct events, want 3", n)
+	}
+	callback.mu.Lock()
+	defer callback.mu.Unlock()
+	var failures int
+	for _, n := range callback.failures {
+		failures += n
+	}
+	if failures != 2 {
+		t.Errorf("callback failed %d times, want 2", failures)
+	}
+	if deadLetters, _ := store.ListByStatus(EventStatusDeadLetter); len(deadLetters) != 0 {
+		t.Errorf("%d events in dead letter", len(deadLetters))
+	}
+}
+
+// reorgIncrement 在模拟链上发送一笔 increment，然后从该交易所在区块的父区块分叉，
+// 让新链比旧链长，被移除的交易重新打包到新链上哈希不同的区块
+func reorgIncrement(t *testing.T, chain *simChain, beforeReorg func(receipt *types.Receipt)) (old, replacement *types.Receipt) {
+	t.Helper()
+	tx := chain.transact(t, chain.counter.Increment)
+	old = chain.requireSuccess(t, tx)
+	if beforeReorg != nil {
+		beforeReorg(old)
+	}
+
+	parent, err := chain.client.HeaderByNumber(context.Background(), new(big.Int).Sub(old.BlockNumber, big.NewInt(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := chain.backend.Fork(parent.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	// 交易池会把旧链上的交易重新放回，新链出块时重新打包；没有放回时手动重新发送
+	chain.backend.Commit()
+	if _, err := chain.client.TransactionReceipt(context.Background(), tx.Hash()); err != nil {
+		if err := chain.client.SendTransaction(context.Background(), tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 新链比旧链长后成为主链
+	for i := 0; i < 3; i++ {
+		chain.backend.Commit()
+	}
+
+	replacement = chain.requireSuccess(t, tx)
+	if replacement.BlockHash == old.BlockHash {
+		t.Fatal("transaction was not moved to another block")
+	}
+	return old, replacement
+}
+
+func TestEventHandlerRollsBackReorgedEvents(t *testing.T) {
+	chain := newSimChain(t)
+	callback := newCountingCallback()
+	store := openSimStore(t, filepath.Join(t.TempDir(), "events.log"))
+	defer store.Close()
+	startSimHandler(t, chain, store, callback, 0)
+
+	// 不等待确认时事件立即处理，重组后先回滚旧区块中的事件，再处理新区块中的事件
+	old, replacement := reorgIncrement(t, chain, func(*types.Receipt) {
+		callback.waitFor(t, "event before reorg", func() bool { return len(callback.processed) == 1 })
+	})
+	callback.waitFor(t, "rollback and reprocessing", func() bool {
+		return len(callback.rolledBack) == 1 && len(callback.processed) == 2
+	})
+
+	callback.assertExactlyOnce(t)
+	oldID := fmt.Sprintf("%s-0-%s", old.TxHash.Hex(), old.BlockHash.Hex())
+	newID := fmt.Sprintf("%s-0-%s", replacement.TxHash.Hex(), replacement.BlockHash.Hex())
+	callback.mu.Lock()
+	defer callback.mu.Unlock()
+	if callback.rolledBack[oldID] != 1 || callback.processed[newID] != 1 {
+		t.Errorf("rolled back %v, processed %v; want old block rolled back and new block processed", callback.rolledBack, callback.processed)
+	}
+	if got := chain.count(t); got != 1 {
+		t.Errorf("count after reorg = %d, want 1", got)
+	}
+}
+
+func TestEventHandlerSkipsReorgedEventsBeforeConfirmation(t *testing.T) {
+	chain := newSimChain(t)
+	callback := newCountingCallback()
+	store := openSimStore(t, filepath.Join(t.TempDir(), "events.log"))
+	defer store.Close()
+	handler := startSimHandler(t, chain, store, callback, 3)
+
+	// 等待确认期间发生重组：旧区块中的事件从未交给回调，只处理新区块中的事件
+	old, replacement := reorgIncrement(t, chain, func(old *types.Receipt) {
+		waitForStored(t, store, fmt.Sprintf("%s:0:", old.TxHash.Hex()))
+	})
+	for i := 0; i < 3; i++ {
+		chain.backend.Commit()
+	}
+	callback.waitFor(t, "confirmed event", func() bool { return len(callback.processed) == 1 })
+
+	callback.assertExactlyOnce(t)
+	callback.mu.Lock()
+	rolledBack := len(callback.rolledBack)
+	callback.mu.Unlock()
+	if rolledBack != 0 {
+		t.Errorf("rollback callback called %d times for an unprocessed event", rolledBack)
+	}
+	if _, ok := callback.processed[fmt.Sprintf("%s-0-%s", replacement.TxHash.Hex(), replacement.BlockHash.Hex())]; !ok {
+		t.Errorf("event in new block %s not processed", replacement.BlockHash.Hex())
+	}
+	if health := handler.Health(); health.HeadBlock < old.BlockNumber.Uint64() {
+		t.Errorf("head block %d not observed", health.HeadBlock)
+	}
+}
+
+// waitForStored 等待存储中出现键以 prefix 开头的事件记录
+func waitForStored(t *testing.T, store EventStore, prefix string) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		for _, status := range allEventStatuses {
+			records, err := store.ListByStatus(status)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, record := range records {
+				if strings.HasPrefix(record.Key(), prefix) {
+					return
+				}
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for event %s to be stored", prefix)
+}
+
+// newSimCLI 创建连接模拟链的命令行工具，结果以JSON输出到返回的缓冲区
+func newSimCLI(t *testing.T, chain *simChain) (*cli, *bytes.Buffer) {
+	t.Helper()
+	cfg := defaultConfig()
+	cfg.Contracts.Counter = chain.contract.Hex()
+	out := &bytes.Buffer{}
+	app := &cli{cfg: cfg, backend: chain.client, chainID: simChainID, json: true, out: out}
+	app.sender = ethtx.NewSender(chain.client, ethtx.NewKeySigner(chain.key), simChainID)
+	return app, out
+}
+
+func runCommand(t *testing.T, app *cli, args ...string) error {
+	t.Helper()
+	cmd, ok := findCommand(args[0])
+	if !ok {
+		t.Fatalf("unknown command %q", args[0])
+	}
+	return cmd.run(app, context.Background(), args[1:])
+}
+
+func TestCLIOnSimulatedBackend(t *testing.T) {
+	chain := newSimChain(t)
+	chain.transact(t, chain.counter.Increment)
+	chain.transact(t, chain.counter.Increment)
+	app, out := newSimCLI(t, chain)
+
+	if err := runCommand(t, app, "get"); err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]string
+	if err := json.Unmarshal(out.Bytes(), &got); err != nil || got["count"] != "2" {
+		t.Errorf("get output %q, want count 2", out.String())
+	}
+
+	out.Reset()
+	if err := runCommand(t, app, "history", "-from", "0"); err != nil {
+		t.Fatal(err)
+	}
+	var events []changeCountEvent
+	decoder := json.NewDecoder(out)
+	for decoder.More() {
+		var event changeCountEvent
+		if err := decoder.Decode(&event); err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, event)
+	}
+	if len(events) != 2 || events[0].NewCount != "1" || events[1].NewCount != "2" || events[1].Action != "increment" {
+		t.Errorf("history events = %+v", events)
+	}
+
+	// 发送交易：后台持续出块，等待交易上链后输出交易后的计数
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		ticker := time.NewTicker(50 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				chain.backend.Commit()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	out.Reset()
+	if err := runCommand(t, app, "reset"); err != nil {
+		t.Fatal(err)
+	}
+	var result txResult
+	if err := json.Unmarshal(out.Bytes(), &result); err != nil || result.Action != "reset" || result.Count != "0" {
+		t.Errorf("reset output %q", out.String())
+	}
+
+	// 计数为0时 decrement 在估算gas阶段失败，不会发送交易
+	err := runCommand(t, app, "decrement")
+	if revert, ok := ethtx.AsRevert(err); !ok || revert.Reason != "Count cannot be negative" {
+		t.Errorf("decrement at zero: err = %v", err)
+	}
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+)
+
+// defaultBackfillBatchSize 补齐历史事件时每次 eth_getLogs 查询的区块数，避免范围过大被节点拒绝
+const defaultBackfillBatchSize uint64 = 2000
+
+// SetStartBlock 设置没有检查点时开始扫描的区块号（通常为合约部署区块）
+func (h *EventHandler) SetStartBlock(block uint64) {
+	h.startBlock = block
+}
+
+// SetBackfillBatchSize 设置补齐历史事件时每次查询的区块数
+func (h *EventHandler) SetBackfillBatchSize(size uint64) {
+	if size > 0 {
+		h.backfillBatchSize = size
+	}
+}
+
+// loadCheckpoint 从存储中加载最后一个已完整处理的区块号
+func (h *EventHandler) loadCheckpoint() {
+	block, ok, err := h.store.LoadBlockCheckpoint()
+	if err != nil {
+		log.Printf("加载区块检查点失败: %v", err)
+		return
+	}
+
+	h.checkpointMutex.Lock()
+	defer h.checkpointMutex.Unlock()
+
+	h.lastBlock, h.hasCheckpoint = block, ok
+	if ok {
+		log.Printf("已加载区块检查点: %d", block)
+	}
+}
+
+// nextBlock 返回下一个需要扫描的区块号，以及当前的检查点回退次数
+func (h *EventHandler) nextBlock() (uint64, uint64) {
+	h.checkpointMutex.Lock()
+	defer h.checkpointMutex.Unlock()
+
+	if !h.hasCheckpoint {
+		return h.startBlock, h.rewinds
+	}
+	return h.lastBlock + 1, h.rewinds
+}
+
+// saveCheckpoint 记录最后一个已完整处理的区块号，检查点只会向前推进
+func (h *EventHandler) saveCheckpoint(block uint64) {
+	h.checkpointMutex.Lock()
+	defer h.checkpointMutex.Unlock()
+
+	h.advanceCheckpointLocked(block)
+}
+
+// saveBackfillCheckpoint 补齐完一批区块后推进检查点；
+// 查询期间确认循环回退过检查点时，这批结果可能来自旧链，不推进检查点并返回 false
+func (h *EventHandler) saveBackfillCheckpoint(block, rewinds uint64) bool {
+	h.checkpointMutex.Lock()
+	defer h.checkpointMutex.Unlock()
+
+	if h.rewinds != rewinds {
+		return false
+	}
+	h.advanceCheckpointLocked(block)
+	return true
+}
+
+// advanceCheckpointLocked 推进检查点，调用方需持有 checkpointMutex
+func (h *EventHandler) advanceCheckpointLocked(block uint64) {
+	if h.hasCheckpoint && block <= h.lastBlock {
+		return
+	}
+	if err := h.store.SaveBlockCheckpoint(block); err != nil {
+		log.Printf("保存区块检查点失败: %v", err)
+		return
+	}
+	h.lastBlock, h.hasCheckpoint = block, true
+}
+
+// rewindCheckpoint 发生链重组时将区块检查点回退到指定区块
+func (h *EventHandler) rewindCheckpoint(block uint64) {
+	h.checkpointMutex.Lock()
+	defer h.checkpointMutex.Unlock()
+
+	if !h.hasCheckpoint || block >= h.lastBlock {
+		return
+	}
+	if err := h.store.SaveBlockCheckpoint(block); err != nil {
+		log.Printf("回退区块检查点失败: %v", err)
+		return
+	}
+	log.Printf("区块检查点已回退: %d -> %d", h.lastBlock, block)
+	h.lastBlock = block
+	h.rewinds++
+}
+
+// saveSubscriptionCheckpoint 根据订阅推送的日志推进检查点；
+// 订阅建立后增加过监听地址时，订阅没有覆盖新地址，不推进检查点
+func (h *EventHandler) saveSubscriptionCheckpoint(block, version uint64) {
+	h.addressesMutex.RLock()
+	defer h.addressesMutex.RUnlock()
+
+	if h.filterVersion != version {
+		return
+	}
+	h.saveCheckpoint(block)
+}
+
+// rescanFrom 让下一轮补齐从 block 开始重新扫描，调用方需持有 addressesMutex；
+// 同时递增回退次数，丢弃按旧过滤条件进行中的补齐结果
+func (h *EventHandler) rescanFrom(block uint64) {
+	h.checkpointMutex.Lock()
+	defer h.checkpointMutex.Unlock()
+
+	h.rewinds++
+	if !h.hasCheckpoint {
+		if block < h.startBlock {
+			h.startBlock = block
+		}
+		return
+	}
+	// 区块0是创世区块，不会有合约在其中被创建
+	if block == 0 || block > h.lastBlock {
+		return
+	}
+	if err := h.store.SaveBlockCheckpoint(block - 1); err != nil {
+		log.Printf("回退区块检查点失败: %v", err)
+		return
+	}
+	log.Printf("区块检查点已回退: %d -> %d（重新扫描新增的监听地址）", h.lastBlock, block-1)
+	h.lastBlock = block - 1
+}
+
+// syncToHead 从检查点之后的区块补齐到当前最新区块
+func (h *EventHandler) syncToHead(ctx context.Context) error {
+	head, err := h.getClient().BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("get latest block number: %w", err)
+	}
+	h.metrics.observeHead(head)
+
+	from, rewinds := h.nextBlock()
+	if from > head {
+		return nil
+	}
+
+	if head-from+1 > h.backfillBatchSize {
+		log.Printf("补齐区块 %d - %d 之间的事件...", from, head)
+	}
+	return h.backfill(ctx, from, head, rewinds)
+}
+
+// backfill 按 backfillBatchSize 分批查询 [from, to] 之间的事件，每批完成后推进检查点；
+// 期间检查点被回退时停止，下一轮从回退后的位置重新扫描
+func (h *EventHandler) backfill(ctx context.Context, from, to, rewinds uint64) error {
+	for start := from; start <= to; {
+		end := start + h.backfillBatchSize - 1
+		if end > to {
+			end = to
+		}
+
+		query := h.filterQuery()
+		query.FromBlock = new(big.Int).SetUint64(start)
+		query.ToBlock = new(big.Int).SetUint64(end)
+
+		logs, err := h.getClient().FilterLogs(ctx, query)
+		if err != nil {
+			return fmt.Errorf("filter blocks %d-%d: %w", start, end, err)
+		}
+
+		for _, raw := range logs {
+			if err := h.saveAndProcessLog(raw); err != nil {
+				return err
+			}
+		}
+
+		if !h.saveBackfillCheckpoint(end, rewinds) {
+			return nil
+		}
+		start = end + 1
+	}
+
+	return nil
+}
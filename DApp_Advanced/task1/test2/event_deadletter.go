@@ -0,0 +1,117 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+// 默认重试策略：第一次重试间隔5秒，之后每次翻倍，最长10分钟，失败3次后进入死信
+const (
+	defaultRetryInterval    = 5 * time.Second
+	defaultMaxRetryInterval = 10 * time.Minute
+	defaultMaxRetryCount    = 3
+)
+
+// errInterrupted 上次运行时事件处理被中断（进程退出）
+var errInterrupted = errors.New("processing interrupted by restart")
+
+// ErrNotDeadLetter 表示指定的事件不在死信中
+var ErrNotDeadLetter = errors.New("event is not dead-lettered")
+
+// SetRetryPolicy 设置失败事件的重试策略，需要在 StartListening 之前调用
+// 第 n 次失败后等待 interval*2^(n-1)（不超过 maxInterval）再重试，失败 maxRetries 次后进入死信
+func (h *EventHandler) SetRetryPolicy(maxRetries int, interval, maxInterval time.Duration) {
+	h.maxRetryCount = maxRetries
+	h.retryInterval = interval
+	h.maxRetryInterval = maxInterval
+}
+
+// retryDelay 返回失败 retryCount 次后到下一次重试的等待时间
+func (h *EventHandler) retryDelay(retryCount int) time.Duration {
+	delay := h.retryInterval
+	for i := 1; i < retryCount; i++ {
+		delay *= 2
+		if delay >= h.maxRetryInterval || delay <= 0 {
+			return h.maxRetryInterval
+		}
+	}
+	return delay
+}
+
+// nextRetryTime 返回失败事件的下一次重试时间，旧记录没有 NextRetry 时根据 LastRetry 计算
+func (h *EventHandler) nextRetryTime(record *EventRecord) time.Time {
+	if !record.NextRetry.IsZero() {
+		return record.NextRetry
+	}
+	return record.LastRetry.Add(h.retryDelay(record.RetryCount))
+}
+
+// markFailed 记录一次处理失败和错误信息，重试次数用尽时转入死信，否则按指数退避安排下一次重试
+func (h *EventHandler) markFailed(key string, processErr error) error {
+	var retryCount int
+	err := h.store.UpdateStatus(key, EventStatusFailed, func(record *EventRecord) {
+		record.RetryCount++
+		retryCount = record.RetryCount
+		record.LastError = processErr.Error()
+		if record.RetryCount >= h.maxRetryCount {
+			record.Status = EventStatusDeadLetter
+			record.NextRetry = time.Time{}
+			return
+		}
+		record.NextRetry = time.Now().Add(h.retryDelay(record.RetryCount))
+	})
+	if err != nil {
+		return err
+	}
+
+	if retryCount >= h.maxRetryCount {
+		log.Printf("处理事件失败 %s: %v, 已失败%d次，转入死信", key, processErr, retryCount)
+	} else {
+		log.Printf("处理事件失败 %s: %v, 重试次数: %d", key, processErr, retryCount)
+	}
+	return nil
+}
+
+// moveToDeadLetter 将重试次数已用尽的失败事件转入死信
+func (h *EventHandler) moveToDeadLetter(key string) {
+	err := h.store.UpdateStatus(key, EventStatusDeadLetter, func(record *EventRecord) {
+		record.NextRetry = time.Time{}
+	})
+	if err != nil {
+		log.Printf("更新事件状态失败 %s: %v", key, err)
+		return
+	}
+	log.Printf("事件重试次数已用尽，转入死信: %s", key)
+}
+
+// DeadLetters 按区块顺序列出所有死信事件，LastError 为最后一次处理失败的错误信息
+func (h *EventHandler) DeadLetters() ([]*EventRecord, error) {
+	return h.store.ListByStatus(EventStatusDeadLetter)
+}
+
+// ReplayDeadLetter 重新处理一条死信事件：重置重试次数后交给重试循环立即处理
+func (h *EventHandler) ReplayDeadLetter(key string) error {
+	return h.updateDeadLetter(key, EventStatusFailed, func(record *EventRecord) {
+		record.RetryCount = 0
+		record.NextRetry = time.Now()
+	})
+}
+
+// DiscardDeadLetter 丢弃一条死信事件，记录仍保留在存储中，但不会再被处理
+func (h *EventHandler) DiscardDeadLetter(key string) error {
+	return h.updateDeadLetter(key, EventStatusDiscarded, nil)
+}
+
+// updateDeadLetter 修改死信事件的状态，事件不在死信中时返回 ErrNotDeadLetter
+func (h *EventHandler) updateDeadLetter(key string, status EventStatus, mutate func(record *EventRecord)) error {
+	record, err := h.store.Get(key)
+	if err != nil {
+		return err
+	}
+	if record.Status != EventStatusDeadLetter {
+		return fmt.Errorf("%w: %s", ErrNotDeadLetter, key)
+	}
+	return h.store.UpdateStatus(key, status, mutate)
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DecodedEvent 根据ABI解码后的事件
+type DecodedEvent struct {
+	Name   string                 // 事件名
+	Fields map[string]interface{} // 事件参数（包括indexed参数），键为ABI中的参数名
+	Raw    types.Log              // 原始日志
+
+	abi *abi.ABI
+}
+
+// Decode 将事件解码到 abigen 生成的事件结构体（如 *Counter.CounterChangeCount）中，
+// 结构体中存在 types.Log 类型的 Raw 字段时会一并填充
+func (e *DecodedEvent) Decode(out interface{}) error {
+	if len(e.Raw.Data) > 0 {
+		if err := e.abi.UnpackIntoInterface(out, e.Name, e.Raw.Data); err != nil {
+			return err
+		}
+	}
+
+	var indexed abi.Arguments
+	for _, arg := range e.abi.Events[e.Name].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, e.Raw.Topics[1:]); err != nil {
+		return err
+	}
+
+	value := reflect.ValueOf(out)
+	if value.Kind() == reflect.Ptr {
+		raw := value.Elem().FieldByName("Raw")
+		if raw.IsValid() && raw.CanSet() && raw.Type() == reflect.TypeOf(types.Log{}) {
+			raw.Set(reflect.ValueOf(e.Raw))
+		}
+	}
+	return nil
+}
+
+// registeredHandler 注册的事件回调
+type registeredHandler struct {
+	onEvent    func(event *DecodedEvent) error // 事件处理回调
+	onRollback func(event *DecodedEvent) error // 已处理事件被重组移除时的补偿回调，可以为nil
+}
+
+// Handle 为指定事件注册基于map的处理回调，onRollback 可以为 nil
+// 需要在 StartListening 之前注册，同一事件可以注册多个回调，按注册顺序调用
+func (h *EventHandler) Handle(eventName string, onEvent, onRollback func(event *DecodedEvent) error) error {
+	if !h.watches(eventName) {
+		return fmt.Errorf("event %q is not watched by this handler", eventName)
+	}
+	if onEvent == nil {
+		return errors.New("onEvent callback is required")
+	}
+
+	h.handlersMutex.Lock()
+	defer h.handlersMutex.Unlock()
+
+	h.handlers[eventName] = append(h.handlers[eventName], registeredHandler{
+		onEvent:    onEvent,
+		onRollback: onRollback,
+	})
+	return nil
+}
+
+// HandleTyped 为指定事件注册强类型的处理回调，T 通常是 abigen 生成的事件结构体
+func HandleTyped[T any](h *EventHandler, eventName string, onEvent, onRollback func(event *T) error) error {
+	wrap := func(fn func(event *T) error) func(event *DecodedEvent) error {
+		if fn == nil {
+			return nil
+		}
+		return func(event *DecodedEvent) error {
+			typed := new(T)
+			if err := event.Decode(typed); err != nil {
+				return fmt.Errorf("decode %s: %w", event.Name, err)
+			}
+			return fn(typed)
+		}
+	}
+	return h.Handle(eventName, wrap(onEvent), wrap(onRollback))
+}
+
+// watches 判断事件是否在监听范围内
+func (h *EventHandler) watches(eventName string) bool {
+	for _, name := range h.eventNames {
+		if name == eventName {
+			return true
+		}
+	}
+	return false
+}
+
+// dispatch 按注册顺序调用事件的处理回调，没有注册回调时打印事件内容
+func (h *EventHandler) dispatch(event *DecodedEvent) error {
+	h.handlersMutex.RLock()
+	handlers := h.handlers[event.Name]
+	h.handlersMutex.RUnlock()
+
+	if len(handlers) == 0 {
+		// 默认处理逻辑
+		fmt.Printf("监听到最新%s事件:\n", event.Name)
+		for name, value := range event.Fields {
+			fmt.Printf("  %s: %v\n", name, value)
+		}
+		fmt.Printf("  TxHash: %s\n\n", event.Raw.TxHash.Hex())
+		return nil
+	}
+
+	for _, handler := range handlers {
+		if err := handler.onEvent(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dispatchRollback 调用事件的补偿回调
+func (h *EventHandler) dispatchRollback(event *DecodedEvent) error {
+	h.handlersMutex.RLock()
+	handlers := h.handlers[event.Name]
+	h.handlersMutex.RUnlock()
+
+	for _, handler := range handlers {
+		if handler.onRollback == nil {
+			continue
+		}
+		if err := handler.onRollback(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeLog 使用ABI解码日志，非indexed参数通过 abi.ABI.UnpackIntoMap 解码，indexed参数从topics中解析
+func (h *EventHandler) decodeLog(raw types.Log) (*DecodedEvent, error) {
+	if len(raw.Topics) == 0 {
+		return nil, errors.New("anonymous logs are not supported")
+	}
+
+	event, err := h.abi.EventByID(raw.Topics[0])
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]interface{})
+	if len(raw.Data) > 0 {
+		if err := h.abi.UnpackIntoMap(fields, event.Name, raw.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(fields, indexed, raw.Topics[1:]); err != nil {
+		return nil, err
+	}
+
+	return &DecodedEvent{
+		Name:   event.Name,
+		Fields: fields,
+		Raw:    raw,
+		abi:    &h.abi,
+	}, nil
+}
+
+// decodeRecord 从存储的事件记录中还原解码后的事件
+func (h *EventHandler) decodeRecord(record *EventRecord) (*DecodedEvent, error) {
+	if record.Log == nil {
+		return nil, errors.New("record has no raw log")
+	}
+	return h.decodeLog(*record.Log)
+}
@@ -2,18 +2,19 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
-	"os"
+	"math/big"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
-	Counter "counter/counter"
-
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
-	"github.com/ethereum/go-ethereum/event"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 // EventStatus 表示事件的处理状态
@@ -23,326 +24,516 @@ const (
 	EventStatusPending    EventStatus = iota // 待处理
 	EventStatusProcessing                    // 处理中
 	EventStatusProcessed                     // 已处理
-	EventStatusFailed                        // 处理失败
+	EventStatusFailed                        // 处理失败，等待退避后重试
+	EventStatusDeadLetter                    // 重试次数用尽，等待人工重放或丢弃
+	EventStatusDiscarded                     // 死信事件已被人工丢弃，不再处理
+)
+
+// ConfirmStatus 表示事件所在区块的确认状态
+type ConfirmStatus int
+
+const (
+	ConfirmUnknown   ConfirmStatus = iota // 旧版记录没有确认信息，不参与确认检查
+	ConfirmPending                        // 确认数不足，等待确认后再处理
+	ConfirmConfirmed                      // 达到确认深度，已交给回调处理，但仍可能被重组
+	ConfirmFinalized                      // 达到最终确认深度，不再检查重组
+	ConfirmRemoved                        // 所在区块已被重组移除
 )
 
 // EventRecord 表示存储的事件记录
 type EventRecord struct {
-	TxHash      string      `json:"tx_hash"`
-	BlockNumber uint64      `json:"block_number"`
-	EventData   interface{} `json:"event_data"`
-	Status      EventStatus `json:"status"`
-	RetryCount  int         `json:"retry_count"`
-	LastRetry   time.Time   `json:"last_retry"`
+	TxHash      string        `json:"tx_hash"`
+	LogIndex    uint          `json:"log_index"`
+	BlockNumber uint64        `json:"block_number"`
+	BlockHash   string        `json:"block_hash,omitempty"`
+	Event       string        `json:"event,omitempty"`
+	Log         *types.Log    `json:"log,omitempty"`
+	EventData   interface{}   `json:"event_data"`
+	Status      EventStatus   `json:"status"`
+	Confirm     ConfirmStatus `json:"confirm,omitempty"`
+	RetryCount  int           `json:"retry_count"`
+	LastRetry   time.Time     `json:"last_retry"`
+	NextRetry   time.Time     `json:"next_retry,omitempty"`
+	LastError   string        `json:"last_error,omitempty"`
+}
+
+// Key 返回事件记录在存储中的唯一键
+// 一笔交易可以产生多条日志，同一笔交易在重组后也可能被打包进不同的区块，
+// 因此事件由 交易哈希 + 日志索引 + 区块哈希 共同标识
+func (r *EventRecord) Key() string {
+	return eventKey(r.TxHash, r.LogIndex, r.BlockHash)
+}
+
+// eventKey 生成事件的唯一键
+func eventKey(txHash string, logIndex uint, blockHash string) string {
+	return fmt.Sprintf("%s:%d:%s", txHash, logIndex, blockHash)
+}
+
+// logKey 生成日志对应事件的唯一键
+func logKey(raw types.Log) string {
+	return eventKey(raw.TxHash.Hex(), raw.Index, raw.BlockHash.Hex())
+}
+
+// ChainClient 事件处理器需要的链上查询能力，*ethclient.Client 实现了该接口
+type ChainClient interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error)
+	SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error)
 }
 
 // EventHandler 提供可靠的事件处理机制
+// 根据合约ABI、合约地址和事件名订阅日志，解码后交给通过 Handle/HandleTyped 注册的回调处理
 type EventHandler struct {
-	instance          *Counter.Counter                              // Counter合约实例
-	client            interface{}                                   // 以太坊客户端实例
-	eventDB           map[string]*EventRecord                       // 简单的内存数据库，可以替换为实际数据库
-	eventDBMutex      sync.RWMutex                                  // 用于保护eventDB的读写锁
-	retryInterval     time.Duration                                 // 事件处理失败后的重试间隔
-	maxRetryCount     int                                           // 事件处理失败后的最大重试次数
-	dbFilePath        string                                        // 事件存储文件的路径
-	isWebSocketClient bool                                          // 标记是否使用WebSocket客户端
-	onEventProcessed  func(event *Counter.CounterChangeCount) error // 事件处理回调函数
-	onReconnect       func() error                                  // 客户端重连回调函数
+	clientMutex       sync.RWMutex                   // 用于保护client，重连时会替换客户端
+	client            ChainClient                    // 当前使用的以太坊客户端实例
+	fallbackClient    ChainClient                    // WebSocket不可用时轮询使用的客户端（通常为HTTP）
+	store             EventStore                     // 事件持久化存储
+	abi               abi.ABI                        // 合约ABI，用于解码日志
+	addressesMutex    sync.RWMutex                   // 用于保护addresses和filterVersion，运行中可以增加监听地址
+	addresses         []common.Address               // 监听的合约地址
+	filterVersion     uint64                         // 过滤条件的版本，每次增加监听地址时递增
+	resubscribe       chan struct{}                  // 过滤条件变化后通知WebSocket订阅重新建立
+	eventNames        []string                       // 监听的事件名
+	topics            []common.Hash                  // 监听事件对应的 topic0
+	handlersMutex     sync.RWMutex                   // 用于保护handlers
+	handlers          map[string][]registeredHandler // 按事件名注册的回调
+	retryInterval     time.Duration                  // 事件处理失败后第一次重试的间隔，之后按指数增长
+	maxRetryInterval  time.Duration                  // 重试间隔的上限
+	maxRetryCount     int                            // 事件处理失败后的最大重试次数，用尽后进入死信
+	startBlock        uint64                         // 没有检查点时开始扫描的区块号
+	backfillBatchSize uint64                         // 补齐历史事件时每次查询的区块数
+	checkpointMutex   sync.Mutex                     // 用于保护区块检查点
+	lastBlock         uint64                         // 最后一个已完整处理的区块号
+	hasCheckpoint     bool                           // 是否已经记录过区块检查点
+	rewinds           uint64                         // 检查点回退次数，用于丢弃回退前开始的补齐结果
+	confirmations     uint64                         // 事件处理前需要的区块确认数，0表示立即处理
+	finalityDepth     uint64                         // 达到该确认数后认为事件不会再被重组
+	workerCount       int                            // 处理事件的工作协程数
+	workerQueueSize   int                            // 每个工作协程的队列长度
+	workerOnce        sync.Once                      // 用于延迟启动工作协程
+	workerQueues      []chan processJob              // 每个工作协程的待处理队列
+	inflightMutex     sync.Mutex                     // 用于保护inflight
+	inflight          map[string]struct{}            // 已提交但尚未处理完成或正在回滚的事件
+	workers           sync.WaitGroup                 // 正在运行的工作协程
+	lifecycleMutex    sync.Mutex                     // 用于保护启动和停止
+	ctx               context.Context                // 处理器的运行上下文，Start 时创建
+	cancel            context.CancelFunc             // 取消运行上下文，停止所有后台循环
+	loops             sync.WaitGroup                 // 正在运行的后台循环
+	stopped           bool                           // 是否已经调用过 Stop
+	queueMutex        sync.RWMutex                   // 用于保护stopping和工作队列的关闭，提交事件时持有读锁
+	stopping          bool                           // 停止后拒绝提交新的事件
+	metrics           *handlerMetrics                // 运行指标，通过 /metrics 暴露
+	maxHealthyLag     uint64                         // /healthz 允许的最大区块落后数
+	pollInterval      time.Duration                  // 轮询新事件和确认循环检查的间隔
+	isWebSocketClient bool                           // 标记是否使用WebSocket客户端
+	dialWebSocket     WebSocketDialer                // 重新建立WebSocket连接的函数
 }
 
 // NewEventHandler 创建新的事件处理器
+// abiJSON 为合约ABI，eventNames 为空时监听ABI中的所有事件
 func NewEventHandler(
-	instance *Counter.Counter,
-	client interface{},
+	client ChainClient,
+	store EventStore,
+	abiJSON string,
+	addresses []common.Address,
+	eventNames []string,
 	isWebSocketClient bool,
-	onEventProcessed func(event *Counter.CounterChangeCount) error,
-	onReconnect func() error,
-) *EventHandler {
+	dialWebSocket WebSocketDialer,
+) (*EventHandler, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, fmt.Errorf("parse contract ABI: %w", err)
+	}
+
+	if len(eventNames) == 0 {
+		for name := range parsed.Events {
+			eventNames = append(eventNames, name)
+		}
+	}
+	var topics []common.Hash
+	for _, name := range eventNames {
+		event, ok := parsed.Events[name]
+		if !ok {
+			return nil, fmt.Errorf("event %q not found in ABI", name)
+		}
+		topics = append(topics, event.ID)
+	}
+
 	handler := &EventHandler{
-		instance:          instance,
 		client:            client,
-		eventDB:           make(map[string]*EventRecord),
-		retryInterval:     5 * time.Second,
-		maxRetryCount:     3,
-		dbFilePath:        "event_store.json",
+		store:             store,
+		abi:               parsed,
+		addresses:         addresses,
+		resubscribe:       make(chan struct{}, 1),
+		eventNames:        eventNames,
+		topics:            topics,
+		handlers:          make(map[string][]registeredHandler),
+		retryInterval:     defaultRetryInterval,
+		maxRetryInterval:  defaultMaxRetryInterval,
+		maxRetryCount:     defaultMaxRetryCount,
+		backfillBatchSize: defaultBackfillBatchSize,
+		finalityDepth:     defaultFinalityDepth,
+		workerCount:       defaultWorkerCount,
+		workerQueueSize:   defaultWorkerQueueSize,
+		inflight:          make(map[string]struct{}),
+		metrics:           newHandlerMetrics(),
+		maxHealthyLag:     defaultMaxHealthyLag,
+		pollInterval:      defaultPollInterval,
 		isWebSocketClient: isWebSocketClient,
-		onEventProcessed:  onEventProcessed,
-		onReconnect:       onReconnect,
+		dialWebSocket:     dialWebSocket,
 	}
 
-	handler.loadEventStore()
-	go handler.startRetryLoop()
+	handler.loadCheckpoint()
+	handler.recoverUnfinishedEvents()
 
-	return handler
+	return handler, nil
 }
 
-// StartListening 开始监听事件
-func (h *EventHandler) StartListening() {
-	if h.isWebSocketClient {
-		h.startWebSocketListening()
-	} else {
-		h.startPollingListening()
-	}
+// filterQuery 返回监听的合约地址和事件对应的日志过滤条件
+func (h *EventHandler) filterQuery() ethereum.FilterQuery {
+	query, _ := h.currentFilter()
+	return query
 }
 
-// startWebSocketListening 使用WebSocket连接监听事件
-func (h *EventHandler) startWebSocketListening() {
-	go func() {
-		for {
-			ctx, cancel := context.WithCancel(context.Background())
-			eventChan := make(chan *Counter.CounterChangeCount, 100) // 使用带缓冲的通道
-			watchOpts := &bind.WatchOpts{Context: ctx}
+// currentFilter 返回当前的日志过滤条件及其版本
+func (h *EventHandler) currentFilter() (ethereum.FilterQuery, uint64) {
+	h.addressesMutex.RLock()
+	defer h.addressesMutex.RUnlock()
 
-			log.Println("开始使用WebSocket实时订阅changeCount事件...")
-			sub, err := h.instance.WatchChangeCount(watchOpts, eventChan)
-			if err != nil {
-				log.Printf("订阅事件错误: %v, 尝试重新订阅...", err)
-				cancel()
-				time.Sleep(2 * time.Second)
-				if h.onReconnect != nil { // 判断函数是否存在
-					if err := h.onReconnect(); err != nil {
-						log.Printf("重连失败: %v", err)
-					}
-				}
-				continue
-			}
+	return ethereum.FilterQuery{
+		Addresses: append([]common.Address(nil), h.addresses...),
+		Topics:    [][]common.Hash{h.topics},
+	}, h.filterVersion
+}
+
+// AddAddresses 在运行中增加监听的合约地址，用于跟踪工厂合约新创建的合约。
+// fromBlock 为新合约最早可能产生事件的区块（通常是创建合约的区块），检查点会回退到该区块之前，
+// 由补齐逻辑按新的过滤条件重新扫描，已记录的事件由存储去重；WebSocket订阅会按新的过滤条件重新建立。
+// 创建处理器时 addresses 为空表示监听所有地址，此时不需要也不能再增加地址
+func (h *EventHandler) AddAddresses(addresses []common.Address, fromBlock uint64) error {
+	h.addressesMutex.Lock()
+	defer h.addressesMutex.Unlock()
 
-			// 处理接收到的事件
-			h.handleEventSubscription(ctx, cancel, sub, eventChan)
+	if len(h.addresses) == 0 {
+		return errors.New("handler watches all addresses")
+	}
+	added := false
+	for _, address := range addresses {
+		if !slices.Contains(h.addresses, address) {
+			h.addresses = append(h.addresses, address)
+			added = true
 		}
-	}()
+	}
+	if !added {
+		return nil
+	}
+
+	// 持有 addressesMutex 回退检查点，使用新过滤条件的订阅和补齐一定从回退后的位置开始扫描
+	h.filterVersion++
+	h.rescanFrom(fromBlock)
+	select {
+	case h.resubscribe <- struct{}{}:
+	default:
+	}
+	return nil
 }
 
-// startPollingListening 使用轮询方式监听事件
-func (h *EventHandler) startPollingListening() {
-	go func() {
-		log.Println("开始使用轮询方式监听changeCount事件...")
-		var lastBlock uint64 = 0
-
-		for {
-			filterOpts := &bind.FilterOpts{
-				Start:   lastBlock,
-				End:     nil,
-				Context: context.Background(),
+// listen 在WebSocket订阅和轮询之间切换：WebSocket持续不可用时回退到轮询，
+// 轮询期间定期尝试重新建立WebSocket连接，成功后切换回实时订阅
+func (h *EventHandler) listen(ctx context.Context) {
+	useWebSocket := h.isWebSocketClient
+	for ctx.Err() == nil {
+		if useWebSocket {
+			h.listenWebSocket(ctx)
+			if ctx.Err() != nil {
+				break
 			}
+			log.Println("WebSocket持续不可用，切换为轮询方式监听事件")
+		}
+		useWebSocket = h.listenPolling(ctx)
+	}
+	log.Println("事件监听已停止")
+}
 
-			iterator, err := h.instance.FilterChangeCount(filterOpts)
-			if err != nil {
-				log.Printf("过滤事件错误: %v, 稍后重试...", err)
-				time.Sleep(5 * time.Second)
-				continue
+// listenWebSocket 使用WebSocket连接监听事件，订阅断开时重连，重连失败或 parent 取消时返回
+func (h *EventHandler) listenWebSocket(parent context.Context) {
+	for parent.Err() == nil {
+		ctx, cancel := context.WithCancel(parent)
+		logChan := make(chan types.Log, 100) // 使用带缓冲的通道
+
+		log.Printf("开始使用WebSocket实时订阅%v事件...", h.eventNames)
+		query, version := h.currentFilter()
+		sub, err := h.getClient().SubscribeFilterLogs(ctx, query, logChan)
+		if err != nil {
+			log.Printf("订阅事件错误: %v, 尝试重新连接...", err)
+			cancel()
+			if err := h.reconnect(parent); err != nil {
+				log.Printf("重连失败: %v", err)
+				return
 			}
+			continue
+		}
 
-			var maxBlockNumber uint64 = lastBlock
-
-			for iterator.Next() {
-				event := iterator.Event
+		// 订阅建立后先补齐断线期间遗漏的事件，再处理实时事件，两者重叠的部分由存储去重
+		if err := h.syncToHead(ctx); err != nil {
+			log.Printf("补齐历史事件失败: %v, 准备重新订阅...", err)
+			sub.Unsubscribe()
+			cancel()
+			sleepContext(parent, 2*time.Second)
+			continue
+		}
 
-				// 只处理新区块中的事件，避免重复处理
-				if event.Raw.BlockNumber > lastBlock {
-					h.saveAndProcessEvent(event)
-				}
+		// 处理接收到的事件，过滤条件变化时直接重新订阅，订阅出错后重新连接
+		resubscribe := h.handleEventSubscription(ctx, cancel, sub, logChan, version)
+		if parent.Err() != nil {
+			return
+		}
+		if resubscribe {
+			continue
+		}
+		if err := h.reconnect(parent); err != nil {
+			log.Printf("重连失败: %v", err)
+			return
+		}
+	}
+}
 
-				// 跟踪最大区块号
-				if event.Raw.BlockNumber > maxBlockNumber {
-					maxBlockNumber = event.Raw.BlockNumber
-				}
-			}
+// listenPolling 使用轮询方式监听事件
+// 配置了 dialWebSocket 时会定期探测WebSocket，恢复后返回 true 以切换回实时订阅，ctx 取消时返回 false
+func (h *EventHandler) listenPolling(ctx context.Context) bool {
+	if h.fallbackClient != nil {
+		h.setClient(h.fallbackClient)
+	}
+	log.Printf("开始使用轮询方式监听%v事件...", h.eventNames)
 
-			// 更新lastBlock为本次轮询中找到的最大区块号
-			lastBlock = maxBlockNumber
+	lastProbe := time.Now()
+	for {
+		// 从检查点之后的区块开始分批查询到最新区块
+		if err := h.syncToHead(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("过滤事件错误: %v, 稍后重试...", err)
+		}
 
-			iterator.Close()
+		// 定期尝试恢复WebSocket连接
+		if h.dialWebSocket != nil && time.Since(lastProbe) >= wsProbeInterval {
+			lastProbe = time.Now()
+			if client, err := h.dialAndProbe(ctx); err == nil {
+				log.Println("WebSocket连接已恢复，切换回实时订阅")
+				h.setClient(client)
+				h.metrics.reconnects.Add(1)
+				return true
+			}
+		}
 
-			// 等待一段时间后再次查询
-			time.Sleep(5 * time.Second)
+		// 等待一段时间后再次查询
+		if !sleepContext(ctx, h.pollInterval) {
+			return false
 		}
-	}()
+	}
 }
 
-// handleEventSubscription 处理事件订阅
+// handleEventSubscription 处理事件订阅，version 为建立订阅时的过滤条件版本
+// 过滤条件变化（增加了监听地址）时返回 true，订阅出错或 ctx 取消时返回 false
 func (h *EventHandler) handleEventSubscription(
 	ctx context.Context,
 	cancel context.CancelFunc,
-	sub event.Subscription,
-	eventChan chan *Counter.CounterChangeCount,
-) {
+	sub ethereum.Subscription,
+	logChan chan types.Log,
+	version uint64,
+) bool {
 	defer func() {
 		sub.Unsubscribe()
 		cancel()
 	}()
 
+	var seenBlock uint64 // 已推进过检查点的最新区块，同一区块的其他日志不再写检查点
 	for {
 		select {
-		case event := <-eventChan:
+		case raw := <-logChan:
 			// 保存并处理事件
-			h.saveAndProcessEvent(event)
+			if err := h.saveAndProcessLog(raw); err != nil {
+				log.Printf("保存事件失败: %v", err)
+				continue
+			}
+			// 订阅按区块顺序推送日志，收到区块N的第一个事件说明N之前的区块已经完整处理
+			if !raw.Removed && raw.BlockNumber > seenBlock {
+				seenBlock = raw.BlockNumber
+				h.saveSubscriptionCheckpoint(raw.BlockNumber-1, version)
+			}
+		case <-h.resubscribe:
+			log.Println("监听地址已变化，准备重新订阅...")
+			return true
 		case err := <-sub.Err():
 			log.Printf("订阅错误: %v, 准备重新订阅...", err)
-			return
+			return false
 		case <-ctx.Done():
-			log.Println("事件监听已取消")
-			return
+			return false
 		}
 	}
 }
 
-// saveAndProcessEvent 保存并处理事件
-func (h *EventHandler) saveAndProcessEvent(event *Counter.CounterChangeCount) {
-	txHash := event.Raw.TxHash.Hex()
+// saveAndProcessLog 保存并处理一条日志，只有保存失败时才返回错误
+func (h *EventHandler) saveAndProcessLog(raw types.Log) error {
+	key := logKey(raw)
 
-	// 检查事件是否已经处理过
-	h.eventDBMutex.RLock()
-	if record, exists := h.eventDB[txHash]; exists {
+	// 订阅推送的被重组移除的日志
+	if raw.Removed {
+		h.handleRemovedLog(raw)
+		return nil
+	}
+
+	// 检查事件是否已经记录过（补齐与实时订阅可能收到同一个事件），未完成的事件交给重试循环处理
+	// 同一笔交易被重新打包到其他区块时键不同，会作为新事件处理，旧区块中的事件由确认循环回滚
+	if record, err := h.store.Get(key); err == nil && record.Confirm != ConfirmRemoved {
 		if record.Status == EventStatusProcessed {
-			h.eventDBMutex.RUnlock()
-			log.Printf("事件已处理，跳过: %s", txHash)
-			return
+			log.Printf("事件已处理，跳过: %s", key)
 		}
+		return nil
+	}
+
+	event, err := h.decodeLog(raw)
+	if err != nil {
+		return fmt.Errorf("decode log %s: %w", key, err)
+	}
+
+	// 没有设置确认深度时立即处理，否则等待确认循环达到确认数后再处理
+	confirm := ConfirmPending
+	if h.confirmations == 0 {
+		confirm = ConfirmConfirmed
 	}
-	h.eventDBMutex.RUnlock()
 
 	// 保存事件
-	h.eventDBMutex.Lock()
-	h.eventDB[txHash] = &EventRecord{
-		TxHash:      txHash,
-		BlockNumber: event.Raw.BlockNumber,
-		EventData:   event,
+	err = h.store.Put(&EventRecord{
+		TxHash:      raw.TxHash.Hex(),
+		LogIndex:    raw.Index,
+		BlockNumber: raw.BlockNumber,
+		BlockHash:   raw.BlockHash.Hex(),
+		Event:       event.Name,
+		Log:         &raw,
+		EventData:   event.Fields,
 		Status:      EventStatusPending,
+		Confirm:     confirm,
 		RetryCount:  0,
 		LastRetry:   time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("save event %s: %w", key, err)
 	}
-	h.eventDBMutex.Unlock()
-
-	// 保存到文件
-	h.saveEventStore()
 
-	// 异步处理事件
-	go h.processEvent(txHash, event)
+	// 交给工作池异步处理，队列已满时在这里阻塞
+	if confirm == ConfirmConfirmed {
+		h.submit(key, event)
+	}
+	return nil
 }
 
 // processEvent 处理单个事件
-func (h *EventHandler) processEvent(txHash string, event *Counter.CounterChangeCount) {
+func (h *EventHandler) processEvent(key string, event *DecodedEvent) {
+	// 提交时读取的记录可能已经过时：提交前事件已被重组回滚的不再处理
+	record, err := h.store.Get(key)
+	if err != nil {
+		log.Printf("读取事件失败 %s: %v", key, err)
+		return
+	}
+	if record.Confirm == ConfirmRemoved {
+		log.Printf("事件已被重组回滚，跳过处理: %s", key)
+		return
+	}
+
 	// 更新状态为处理中
-	h.eventDBMutex.Lock()
-	record := h.eventDB[txHash]
-	record.Status = EventStatusProcessing
-	record.LastRetry = time.Now()
-	h.eventDBMutex.Unlock()
-	h.saveEventStore()
-
-	// 调用用户定义的处理函数
-	var processErr error
-	if h.onEventProcessed != nil {
-		processErr = h.onEventProcessed(event)
-	} else {
-		// 默认处理逻辑
-		fmt.Printf("监听到最新changeCount事件:\n")
-		fmt.Printf("  Action: %s\n", event.Action)
-		fmt.Printf("  By: %s\n", event.By.String())
-		fmt.Printf("  NewCount: %s\n", event.NewCount.String())
-		fmt.Printf("  TxHash: %s\n\n", event.Raw.TxHash.Hex())
+	err = h.store.UpdateStatus(key, EventStatusProcessing, func(record *EventRecord) {
+		record.LastRetry = time.Now()
+	})
+	if err != nil {
+		log.Printf("更新事件状态失败 %s: %v", key, err)
+		return
 	}
 
+	// 调用注册的处理函数
+	started := time.Now()
+	processErr := h.dispatch(event)
+	h.metrics.observeProcessing(event.Raw.BlockNumber, time.Since(started), processErr)
+
 	// 更新处理结果
-	h.eventDBMutex.Lock()
 	if processErr != nil {
-		record.Status = EventStatusFailed
-		record.RetryCount++
-		log.Printf("处理事件失败 %s: %v, 重试次数: %d", txHash, processErr, record.RetryCount)
+		err = h.markFailed(key, processErr)
 	} else {
-		record.Status = EventStatusProcessed
-		log.Printf("事件处理成功: %s", txHash)
+		err = h.store.UpdateStatus(key, EventStatusProcessed, func(record *EventRecord) {
+			record.NextRetry = time.Time{}
+			record.LastError = ""
+		})
+		log.Printf("事件处理成功: %s", key)
+	}
+	if err != nil {
+		log.Printf("更新事件状态失败 %s: %v", key, err)
 	}
-	h.eventDBMutex.Unlock()
-	h.saveEventStore()
 }
 
-// startRetryLoop 启动重试循环，处理失败的事件
-func (h *EventHandler) startRetryLoop() {
-	for {
-		time.Sleep(h.retryInterval)
+// startRetryLoop 启动重试循环，处理失败的事件，ctx 取消时退出
+func (h *EventHandler) startRetryLoop(ctx context.Context) {
+	for sleepContext(ctx, h.retryInterval) {
 		h.retryFailedEvents()
 	}
 }
 
 // retryFailedEvents 重试处理失败的事件
 func (h *EventHandler) retryFailedEvents() {
-	h.eventDBMutex.RLock()
-	// 收集需要重试的事件
-	var toRetry []string
-	for txHash, record := range h.eventDB {
-		if record.Status == EventStatusFailed && record.RetryCount < h.maxRetryCount {
-			// 检查是否可以重试（至少间隔一段时间）
-			if time.Since(record.LastRetry) >= h.retryInterval {
-				toRetry = append(toRetry, txHash)
-			}
-		}
+	failed, err := h.store.ListByStatus(EventStatusFailed)
+	if err != nil {
+		log.Printf("读取失败事件列表失败: %v", err)
+		return
 	}
-	h.eventDBMutex.RUnlock()
-
-	// 重试事件
-	for _, txHash := range toRetry {
-		h.eventDBMutex.RLock()
-		record := h.eventDB[txHash]
-		eventData, ok := record.EventData.(*Counter.CounterChangeCount)
-		h.eventDBMutex.RUnlock()
 
-		if ok {
-			go h.processEvent(txHash, eventData)
+	for _, record := range failed {
+		// 等待确认或已被重组移除的事件不重试
+		if record.Confirm == ConfirmPending || record.Confirm == ConfirmRemoved {
+			continue
+		}
+		// 重试次数用尽的旧记录转入死信
+		if record.RetryCount >= h.maxRetryCount {
+			h.moveToDeadLetter(record.Key())
+			continue
+		}
+		// 检查是否到达下一次重试时间
+		if time.Now().Before(h.nextRetryTime(record)) {
+			continue
 		}
-	}
-}
-
-// saveEventStore 将事件存储保存到文件
-func (h *EventHandler) saveEventStore() {
-	h.eventDBMutex.RLock()
-	defer h.eventDBMutex.RUnlock()
 
-	file, err := os.Create(h.dbFilePath)
-	if err != nil {
-		log.Printf("无法创建事件存储文件: %v", err)
-		return
-	}
-	defer file.Close()
+		event, err := h.decodeRecord(record)
+		if err != nil {
+			log.Printf("解析事件数据失败 %s: %v", record.Key(), err)
+			continue
+		}
 
-	encoder := json.NewEncoder(file)
-	if err := encoder.Encode(h.eventDB); err != nil {
-		log.Printf("保存事件存储失败: %v", err)
+		h.submit(record.Key(), event)
 	}
 }
 
-// loadEventStore 从文件加载事件存储
-func (h *EventHandler) loadEventStore() {
-	file, err := os.Open(h.dbFilePath)
-	if errors.Is(err, os.ErrNotExist) {
-		// 文件不存在，创建新的
-		log.Println("事件存储文件不存在，将创建新的")
-		h.saveEventStore()
-		return
-	}
-	if err != nil {
-		log.Printf("打开事件存储文件失败: %v", err)
-		return
-	}
-	defer file.Close()
-
-	h.eventDBMutex.Lock()
-	defer h.eventDBMutex.Unlock()
-
-	decoder := json.NewDecoder(file)
-	if err := decoder.Decode(&h.eventDB); err != nil {
-		log.Printf("加载事件存储失败: %v", err)
-		h.eventDB = make(map[string]*EventRecord) // 重置为新的映射
-	}
+// recoverUnfinishedEvents 将上次运行中未完成的事件标记为失败，以便重试
+func (h *EventHandler) recoverUnfinishedEvents() {
+	for _, status := range []EventStatus{EventStatusPending, EventStatusProcessing} {
+		records, err := h.store.ListByStatus(status)
+		if err != nil {
+			log.Printf("读取未完成事件失败: %v", err)
+			return
+		}
 
-	// 重新处理未完成的事件
-	for txHash, record := range h.eventDB {
-		if record.Status == EventStatusPending || record.Status == EventStatusProcessing {
-			// 将处理中状态的事件标记为失败，以便重试
-			record.Status = EventStatusFailed
-			record.RetryCount++
-			log.Printf("发现未完成的事件，将重试: %s", txHash)
+		for _, record := range records {
+			// 仍在等待确认的事件由确认循环继续处理
+			if record.Confirm == ConfirmPending {
+				continue
+			}
+			// 处理中断的事件计入一次失败，尚未开始处理的事件直接等待重试
+			if status == EventStatusProcessing {
+				err = h.markFailed(record.Key(), errInterrupted)
+			} else {
+				err = h.store.UpdateStatus(record.Key(), EventStatusFailed, nil)
+			}
+			if err != nil {
+				log.Printf("更新事件状态失败 %s: %v", record.Key(), err)
+				continue
+			}
+			log.Printf("发现未完成的事件，将重试: %s", record.Key())
 		}
 	}
 }
@@ -0,0 +1,1193 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	Counter "counter/counter"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+var (
+	testContract  = common.HexToAddress("0x42c3e45FF2E9AF12F21f5FEF6F7B874aDB9eBeBc")
+	testEventSig  = common.HexToHash("0xcf0df9ae6b82254a39a54216627880509e2f1eea349da908154ef83588638fe8")
+	testBlockHash = common.HexToHash("0x720363795ba10bcdfd5fc8fb964de54cd0cb09b5bc1740bbe8a0b96d1e1f80fe")
+)
+
+// newTestLog 构造一条 changeCount("increment", 1, newCount) 日志
+func newTestLog(t *testing.T, txHash common.Hash, logIndex uint, newCount int64) types.Log {
+	t.Helper()
+	parsed, err := Counter.CounterMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := parsed.Events["changeCount"].Inputs.NonIndexed().Pack("increment", big.NewInt(1), big.NewInt(newCount))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.Log{
+		Address:     testContract,
+		Topics:      []common.Hash{testEventSig},
+		Data:        data,
+		BlockNumber: 9138573,
+		TxHash:      txHash,
+		BlockHash:   testBlockHash,
+		Index:       logIndex,
+	}
+}
+
+// newTestHandler 创建只监听Counter合约changeCount事件的处理器
+func newTestHandler(t *testing.T, store EventStore, callback *recordingCallback) *EventHandler {
+	t.Helper()
+	handler, err := NewEventHandler(nil, store, Counter.CounterMetaData.ABI, []common.Address{testContract}, []string{"changeCount"}, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := HandleTyped(handler, "changeCount", callback.handle, nil); err != nil {
+		t.Fatal(err)
+	}
+	return handler
+}
+
+// recordingCallback 记录回调收到的事件
+type recordingCallback struct {
+	mu     sync.Mutex
+	events []*Counter.CounterChangeCount
+	done   chan struct{}
+}
+
+func newRecordingCallback() *recordingCallback {
+	return &recordingCallback{done: make(chan struct{}, 100)}
+}
+
+func (c *recordingCallback) handle(event *Counter.CounterChangeCount) error {
+	c.mu.Lock()
+	c.events = append(c.events, event)
+	c.mu.Unlock()
+	c.done <- struct{}{}
+	return nil
+}
+
+func (c *recordingCallback) wait(t *testing.T, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		select {
+		case <-c.done:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for event %d/%d", i+1, n)
+		}
+	}
+}
+
+func (c *recordingCallback) count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.events)
+}
+
+// waitProcessed 等待指定数量的事件在存储中变为已处理，避免与 processEvent 的最后一次状态更新竞争
+func waitProcessed(t *testing.T, store EventStore, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		processed, err := store.ListByStatus(EventStatusProcessed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(processed) >= n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d processed events", n)
+}
+
+func TestSaveAndProcessLogMultiLogTransaction(t *testing.T) {
+	store, err := NewLogEventStore(filepath.Join(t.TempDir(), "event_store.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	callback := newRecordingCallback()
+	handler := newTestHandler(t, store, callback)
+
+	// 同一笔交易中调用了两次 increment，产生两条日志
+	txHash := common.HexToHash("0x83260ea254cd0ad4e7485d7a8041c66c1b9368cf064da31bfd7d8519a5639007")
+	first := newTestLog(t, txHash, 4, 1)
+	second := newTestLog(t, txHash, 5, 2)
+
+	for _, raw := range []types.Log{first, second} {
+		if err := handler.saveAndProcessLog(raw); err != nil {
+			t.Fatal(err)
+		}
+	}
+	callback.wait(t, 2)
+	waitProcessed(t, store, 2)
+
+	// 补齐和实时订阅重复收到同样的日志时不会再次处理
+	for _, raw := range []types.Log{first, second} {
+		if err := handler.saveAndProcessLog(raw); err != nil {
+			t.Fatal(err)
+		}
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	if got := callback.count(); got != 2 {
+		t.Fatalf("callback invoked %d times, want 2", got)
+	}
+
+	for i, raw := range []types.Log{first, second} {
+		record, err := store.Get(logKey(raw))
+		if err != nil {
+			t.Fatalf("record for log %d: %v", raw.Index, err)
+		}
+		if record.Status != EventStatusProcessed {
+			t.Errorf("log %d status = %d, want processed", raw.Index, record.Status)
+		}
+		if record.TxHash != txHash.Hex() || record.LogIndex != raw.Index || record.BlockHash != testBlockHash.Hex() {
+			t.Errorf("log %d identity = (%s, %d, %s)", raw.Index, record.TxHash, record.LogIndex, record.BlockHash)
+		}
+		if record.Event != "changeCount" {
+			t.Errorf("log %d event = %q, want changeCount", raw.Index, record.Event)
+		}
+		if fields, ok := record.EventData.(map[string]interface{}); !ok || fields["newCount"].(*big.Int).Int64() != int64(i+1) {
+			t.Errorf("log %d decoded fields = %v", raw.Index, record.EventData)
+		}
+	}
+}
+
+func TestSaveAndProcessLogSameTxDifferentBlock(t *testing.T) {
+	store, err := NewLogEventStore(filepath.Join(t.TempDir(), "event_store.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	callback := newRecordingCallback()
+	handler := newTestHandler(t, store, callback)
+
+	// 重组后同一笔交易被打包进另一个区块，日志索引也可能相同
+	txHash := common.HexToHash("0x99f378a40fc103d323f69f0023788cc5e49f29a6bcf0c2986ac05c558bcbe3d9")
+	original := newTestLog(t, txHash, 0, 1)
+	reincluded := newTestLog(t, txHash, 0, 1)
+	reincluded.BlockHash = common.HexToHash("0x01")
+	reincluded.BlockNumber++
+
+	for _, raw := range []types.Log{original, reincluded} {
+		if err := handler.saveAndProcessLog(raw); err != nil {
+			t.Fatal(err)
+		}
+	}
+	callback.wait(t, 2)
+
+	if logKey(original) == logKey(reincluded) {
+		t.Fatal("events in different blocks share the same key")
+	}
+}
+
+// writeLegacyStore 按旧版格式（以交易哈希为键，事件数据为 CounterChangeCount）写入 event_store.json
+func writeLegacyStore(t *testing.T, path string, logs ...types.Log) {
+	t.Helper()
+	legacy := make(map[string]map[string]interface{})
+	for _, raw := range logs {
+		legacy[raw.TxHash.Hex()] = map[string]interface{}{
+			"tx_hash":      raw.TxHash.Hex(),
+			"block_number": raw.BlockNumber,
+			"event_data": &Counter.CounterChangeCount{
+				Action:   "increment",
+				By:       big.NewInt(1),
+				NewCount: big.NewInt(1),
+				Raw:      raw,
+			},
+			"status":      EventStatusProcessed,
+			"retry_count": 0,
+			"last_retry":  time.Now(),
+		}
+	}
+	data, err := json.Marshal(legacy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestJSONEventStoreMigratesLegacyKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "event_store.json")
+	raw := newTestLog(t, common.HexToHash("0xaa"), 7, 3)
+	writeLegacyStore(t, path, raw)
+
+	store, err := NewJSONEventStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record, err := store.Get(logKey(raw))
+	if err != nil {
+		t.Fatalf("migrated record not found: %v", err)
+	}
+	if record.LogIndex != 7 || record.BlockHash != testBlockHash.Hex() {
+		t.Errorf("migrated identity = (%d, %s)", record.LogIndex, record.BlockHash)
+	}
+	if record.Log == nil || record.Log.TxHash != raw.TxHash {
+		t.Errorf("migrated raw log = %+v", record.Log)
+	}
+	if _, err := store.Get(raw.TxHash.Hex()); err != ErrEventNotFound {
+		t.Errorf("legacy key still present: %v", err)
+	}
+
+	// 迁移结果已经写回文件
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var onDisk map[string]json.RawMessage
+	if err := json.Unmarshal(data, &onDisk); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := onDisk[logKey(raw)]; !ok {
+		t.Errorf("event_store.json was not rewritten with the new keys")
+	}
+}
+
+func TestImportLegacyStoreIntoLogStore(t *testing.T) {
+	dir := t.TempDir()
+	legacyPath := filepath.Join(dir, "event_store.json")
+	txHash := common.HexToHash("0xbb")
+	first := newTestLog(t, txHash, 0, 1)
+	writeLegacyStore(t, legacyPath, first)
+
+	logPath := filepath.Join(dir, "event_store.log")
+	store, err := NewLogEventStore(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	imported, err := ImportLegacyStore(store, legacyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported != 1 {
+		t.Fatalf("imported %d records, want 1", imported)
+	}
+
+	// 再次导入不会重复写入
+	if imported, err := ImportLegacyStore(store, legacyPath); err != nil || imported != 0 {
+		t.Fatalf("second import = (%d, %v), want (0, nil)", imported, err)
+	}
+
+	// 同一笔交易的第二条日志写入后，重新打开日志存储仍能读到两条记录
+	second := newTestLog(t, txHash, 1, 2)
+	if err := store.Put(&EventRecord{
+		TxHash:      txHash.Hex(),
+		LogIndex:    1,
+		BlockNumber: second.BlockNumber,
+		BlockHash:   testBlockHash.Hex(),
+		Log:         &second,
+		Status:      EventStatusProcessed,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	store.Close()
+
+	reopened, err := NewLogEventStore(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+
+	processed, err := reopened.ListByStatus(EventStatusProcessed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(processed) != 2 {
+		t.Fatalf("got %d processed records, want 2", len(processed))
+	}
+}
+
+func TestEventStoresIndexRecordsByStatus(t *testing.T) {
+	dir := t.TempDir()
+	for name, open := range map[string]func() (EventStore, error){
+		"log":  func() (EventStore, error) { return NewLogEventStore(filepath.Join(dir, "event_store.log")) },
+		"json": func() (EventStore, error) { return NewJSONEventStore(filepath.Join(dir, "event_store.json")) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			store, err := open()
+			if err != nil {
+				t.Fatal(err)
+			}
+			records := []*EventRecord{
+				{TxHash: "0x01", BlockNumber: 30, Status: EventStatusPending, Confirm: ConfirmPending},
+				{TxHash: "0x02", BlockNumber: 10, Status: EventStatusProcessed, Confirm: ConfirmConfirmed},
+				{TxHash: "0x03", BlockNumber: 20, Status: EventStatusProcessed, Confirm: ConfirmFinalized},
+				{TxHash: "0x04", BlockNumber: 5, Status: EventStatusFailed, Confirm: ConfirmRemoved},
+				{TxHash: "0x05", BlockNumber: 1, Status: EventStatusProcessed, Confirm: ConfirmUnknown},
+			}
+			for _, record := range records {
+				if err := store.Put(record); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			// 确认状态和处理状态变化后索引随之更新
+			if err := store.UpdateStatus(records[1].Key(), EventStatusProcessed, func(r *EventRecord) { r.Confirm = ConfirmFinalized }); err != nil {
+				t.Fatal(err)
+			}
+			if err := store.UpdateStatus(records[0].Key(), EventStatusFailed, func(r *EventRecord) { r.Confirm = ConfirmConfirmed }); err != nil {
+				t.Fatal(err)
+			}
+			if err := store.Put(&EventRecord{TxHash: "0x06", BlockNumber: 25, Status: EventStatusProcessing, Confirm: ConfirmConfirmed}); err != nil {
+				t.Fatal(err)
+			}
+
+			check := func(store EventStore) {
+				t.Helper()
+				keys := func(records []*EventRecord, err error) string {
+					if err != nil {
+						t.Fatal(err)
+					}
+					var keys []string
+					for _, record := range records {
+						keys = append(keys, record.TxHash)
+					}
+					return strings.Join(keys, ",")
+				}
+				if got := keys(store.ListUnfinalized()); got != "0x06,0x01" {
+					t.Errorf("unfinalized = %s, want 0x06,0x01", got)
+				}
+				if got := keys(store.ListByStatus(EventStatusProcessed)); got != "0x05,0x02,0x03" {
+					t.Errorf("processed = %s", got)
+				}
+				if got := keys(store.ListByStatus(EventStatusFailed)); got != "0x04,0x01" {
+					t.Errorf("failed = %s", got)
+				}
+				if got := keys(store.ListByStatus(EventStatusPending)); got != "" {
+					t.Errorf("pending = %s", got)
+				}
+
+				// 各状态的数量随状态变化更新，供 /metrics 使用
+				counts, err := store.CountByStatus()
+				if err != nil {
+					t.Fatal(err)
+				}
+				want := map[EventStatus]int{EventStatusProcessed: 3, EventStatusFailed: 2, EventStatusProcessing: 1}
+				for _, status := range allEventStatuses {
+					if counts[status] != want[status] {
+						t.Errorf("count(%s) = %d, want %d", eventStatusNames[status], counts[status], want[status])
+					}
+				}
+			}
+			check(store)
+
+			// 重新打开时从文件重建索引
+			store.Close()
+			reopened, err := open()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer reopened.Close()
+			check(reopened)
+		})
+	}
+}
+
+func TestEventStoresRejectCorruptFiles(t *testing.T) {
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "event_store.log")
+	store, err := NewLogEventStore(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, block := range []uint64{1, 2} {
+		if err := store.SaveBlockCheckpoint(block); err != nil {
+			t.Fatal(err)
+		}
+	}
+	store.Close()
+	valid, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 进程写入时崩溃留下的不完整末行被截断，之前的记录保留
+	if err := os.WriteFile(logPath, append(slices.Clone(valid), `{"op":"check`...), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	store, err = NewLogEventStore(logPath)
+	if err != nil {
+		t.Fatalf("torn tail: %v", err)
+	}
+	if block, ok, _ := store.LoadBlockCheckpoint(); !ok || block != 2 {
+		t.Errorf("checkpoint after torn tail = %d, %v; want 2", block, ok)
+	}
+	store.Close()
+
+	// 中间的损坏行报告偏移量，不截断其后的有效记录
+	lines := strings.SplitAfter(string(valid), "\n")
+	corrupt := lines[0] + "not json\n" + lines[1]
+	if err := os.WriteFile(logPath, []byte(corrupt), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	want := fmt.Sprintf("offset %d", len(lines[0]))
+	if _, err := NewLogEventStore(logPath); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("corrupt log: err = %v, want %q", err, want)
+	}
+	if data, _ := os.ReadFile(logPath); string(data) != corrupt {
+		t.Errorf("corrupt log was modified:\n%s", data)
+	}
+
+	// JSON存储无法解析时返回错误，不会以空存储覆盖文件
+	jsonPath := filepath.Join(dir, "event_store.json")
+	if err := os.WriteFile(jsonPath, []byte(`{"0xaa": {`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewJSONEventStore(jsonPath); err == nil || !strings.Contains(err.Error(), "decode event store") {
+		t.Errorf("corrupt JSON store: err = %v", err)
+	}
+	if data, _ := os.ReadFile(jsonPath); string(data) != `{"0xaa": {` {
+		t.Errorf("corrupt JSON store was modified: %s", data)
+	}
+}
+
+func TestDecodeLogIntoMapAndTypedEvent(t *testing.T) {
+	store, err := NewLogEventStore(filepath.Join(t.TempDir(), "event_store.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	handler := newTestHandler(t, store, newRecordingCallback())
+	raw := newTestLog(t, common.HexToHash("0xcc"), 0, 42)
+
+	event, err := handler.decodeLog(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Name != "changeCount" {
+		t.Fatalf("event name = %q, want changeCount", event.Name)
+	}
+	if event.Fields["action"] != "increment" || event.Fields["newCount"].(*big.Int).Int64() != 42 {
+		t.Errorf("decoded fields = %v", event.Fields)
+	}
+
+	var typed Counter.CounterChangeCount
+	if err := event.Decode(&typed); err != nil {
+		t.Fatal(err)
+	}
+	if typed.Action != "increment" || typed.By.Int64() != 1 || typed.NewCount.Int64() != 42 {
+		t.Errorf("typed event = %+v", typed)
+	}
+	if typed.Raw.TxHash != raw.TxHash {
+		t.Errorf("typed event Raw not populated")
+	}
+
+	if err := handler.Handle("Transfer", func(*DecodedEvent) error { return nil }, nil); err == nil {
+		t.Error("registering a handler for an unwatched event should fail")
+	}
+}
+
+// waitStatus 等待事件在存储中变为指定状态
+func waitStatus(t *testing.T, store EventStore, key string, status EventStatus) *EventRecord {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		record, err := store.Get(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if record.Status == status {
+			return record
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %s to reach status %d", key, status)
+	return nil
+}
+
+func TestFailedEventBacksOffIntoDeadLetter(t *testing.T) {
+	store, err := NewLogEventStore(filepath.Join(t.TempDir(), "event_store.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	handler, err := NewEventHandler(nil, store, Counter.CounterMetaData.ABI, []common.Address{testContract}, []string{"changeCount"}, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler.SetRetryPolicy(2, time.Hour, 2*time.Hour)
+	if got := handler.retryDelay(3); got != 2*time.Hour {
+		t.Errorf("retryDelay(3) = %v, want capped at 2h", got)
+	}
+
+	var mu sync.Mutex
+	failing := true
+	attempts := 0
+	err = handler.Handle("changeCount", func(*DecodedEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if failing {
+			return errors.New("downstream unavailable")
+		}
+		return nil
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw := newTestLog(t, common.HexToHash("0xdd"), 0, 1)
+	key := logKey(raw)
+	if err := handler.saveAndProcessLog(raw); err != nil {
+		t.Fatal(err)
+	}
+
+	// 第一次失败后安排在一小时后重试，重试循环不应提前处理
+	record := waitStatus(t, store, key, EventStatusFailed)
+	if record.RetryCount != 1 || record.LastError != "downstream unavailable" {
+		t.Fatalf("after first failure: retry_count=%d last_error=%q", record.RetryCount, record.LastError)
+	}
+	if delay := time.Until(record.NextRetry); delay < 59*time.Minute {
+		t.Fatalf("next retry in %v, want about 1h", delay)
+	}
+	handler.retryFailedEvents()
+	time.Sleep(50 * time.Millisecond)
+	mu.Lock()
+	if attempts != 1 {
+		t.Fatalf("event retried before backoff elapsed: %d attempts", attempts)
+	}
+	mu.Unlock()
+
+	// 到达重试时间后再次失败，重试次数用尽进入死信
+	if err := store.UpdateStatus(key, EventStatusFailed, func(record *EventRecord) {
+		record.NextRetry = time.Now().Add(-time.Second)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	handler.retryFailedEvents()
+	record = waitStatus(t, store, key, EventStatusDeadLetter)
+	if record.RetryCount != 2 || record.LastError != "downstream unavailable" {
+		t.Fatalf("dead letter: retry_count=%d last_error=%q", record.RetryCount, record.LastError)
+	}
+
+	deadLetters, err := handler.DeadLetters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deadLetters) != 1 || deadLetters[0].Key() != key {
+		t.Fatalf("DeadLetters() = %v", deadLetters)
+	}
+
+	// 重放后处理成功
+	mu.Lock()
+	failing = false
+	mu.Unlock()
+	if err := handler.ReplayDeadLetter(key); err != nil {
+		t.Fatal(err)
+	}
+	handler.retryFailedEvents()
+	record = waitStatus(t, store, key, EventStatusProcessed)
+	if record.LastError != "" {
+		t.Errorf("last_error not cleared after successful replay: %q", record.LastError)
+	}
+
+	if err := handler.DiscardDeadLetter(key); !errors.Is(err, ErrNotDeadLetter) {
+		t.Errorf("DiscardDeadLetter on processed event = %v, want ErrNotDeadLetter", err)
+	}
+}
+
+func TestDiscardDeadLetter(t *testing.T) {
+	store, err := NewLogEventStore(filepath.Join(t.TempDir(), "event_store.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	handler := newTestHandler(t, store, newRecordingCallback())
+	raw := newTestLog(t, common.HexToHash("0xee"), 0, 1)
+	if err := store.Put(&EventRecord{
+		TxHash:      raw.TxHash.Hex(),
+		LogIndex:    raw.Index,
+		BlockNumber: raw.BlockNumber,
+		BlockHash:   raw.BlockHash.Hex(),
+		Event:       "changeCount",
+		Log:         &raw,
+		Status:      EventStatusDeadLetter,
+		Confirm:     ConfirmConfirmed,
+		RetryCount:  3,
+		LastError:   "boom",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := handler.DiscardDeadLetter(logKey(raw)); err != nil {
+		t.Fatal(err)
+	}
+	record, err := store.Get(logKey(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Status != EventStatusDiscarded {
+		t.Fatalf("status = %d, want discarded", record.Status)
+	}
+	if deadLetters, _ := handler.DeadLetters(); len(deadLetters) != 0 {
+		t.Fatalf("discarded event still listed as dead letter")
+	}
+}
+
+func TestWorkerPoolOrdersEventsWithinBlock(t *testing.T) {
+	store, err := NewLogEventStore(filepath.Join(t.TempDir(), "event_store.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	handler, err := NewEventHandler(nil, store, Counter.CounterMetaData.ABI, []common.Address{testContract}, []string{"changeCount"}, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler.SetWorkers(2, 1)
+
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	order := make(map[uint64][]uint)
+	err = handler.Handle("changeCount", func(event *DecodedEvent) error {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		running--
+		order[event.Raw.BlockNumber] = append(order[event.Raw.BlockNumber], event.Raw.Index)
+		mu.Unlock()
+		return nil
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const blocks, logsPerBlock = 4, 5
+	for block := uint64(0); block < blocks; block++ {
+		blockHash := common.BigToHash(new(big.Int).SetUint64(block + 1))
+		for index := uint(0); index < logsPerBlock; index++ {
+			raw := newTestLog(t, common.BigToHash(big.NewInt(int64(block*100)+int64(index)+1)), index, 1)
+			raw.BlockNumber = 100 + block
+			raw.BlockHash = blockHash
+			if err := handler.saveAndProcessLog(raw); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	waitProcessed(t, store, blocks*logsPerBlock)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if maxRunning > 2 {
+		t.Errorf("%d callbacks ran concurrently, want at most 2 workers", maxRunning)
+	}
+	for block, indexes := range order {
+		for i, index := range indexes {
+			if index != uint(i) {
+				t.Fatalf("block %d processed out of order: %v", block, indexes)
+			}
+		}
+	}
+}
+
+func TestSubmitSkipsInflightEvent(t *testing.T) {
+	store, err := NewLogEventStore(filepath.Join(t.TempDir(), "event_store.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	handler, err := NewEventHandler(nil, store, Counter.CounterMetaData.ABI, []common.Address{testContract}, []string{"changeCount"}, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	release := make(chan struct{})
+	calls := make(chan struct{}, 10)
+	err = handler.Handle("changeCount", func(*DecodedEvent) error {
+		calls <- struct{}{}
+		<-release
+		return nil
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw := newTestLog(t, common.HexToHash("0xff"), 0, 1)
+	if err := handler.saveAndProcessLog(raw); err != nil {
+		t.Fatal(err)
+	}
+	<-calls
+
+	// 事件仍在处理中，再次提交（例如重试循环）应被跳过
+	event, err := handler.decodeLog(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if handler.submit(logKey(raw), event) {
+		t.Error("submit accepted an event that is still being processed")
+	}
+	close(release)
+	waitProcessed(t, store, 1)
+
+	select {
+	case <-calls:
+		t.Error("callback ran twice for the same event")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestSubmitDuringStop(t *testing.T) {
+	store, err := NewLogEventStore(filepath.Join(t.TempDir(), "event_store.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	handler, err := NewEventHandler(nil, store, Counter.CounterMetaData.ABI, []common.Address{testContract}, []string{"changeCount"}, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler.SetWorkers(2, 0)
+	release := make(chan struct{})
+	if err := handler.Handle("changeCount", func(*DecodedEvent) error { <-release; return nil }, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// 工作协程阻塞在回调中，提交的事件等待放入队列时调用 Stop：
+	// 这些事件要么被处理，要么被拒绝，不会发送到已关闭的队列
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		raw := newTestLog(t, common.HexToHash("0xee"), uint(i), int64(i+1))
+		if err := store.Put(&EventRecord{
+			TxHash:      raw.TxHash.Hex(),
+			LogIndex:    raw.Index,
+			BlockNumber: raw.BlockNumber,
+			BlockHash:   raw.BlockHash.Hex(),
+			Log:         &raw,
+			Status:      EventStatusPending,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		event, err := handler.decodeLog(raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			handler.submit(logKey(raw), event)
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	stopped := make(chan error)
+	go func() { stopped <- handler.Stop() }()
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	if err := <-stopped; err != nil {
+		t.Fatal(err)
+	}
+	wg.Wait()
+}
+
+func TestRollbackDoesNotInterleaveWithProcessing(t *testing.T) {
+	store, err := NewLogEventStore(filepath.Join(t.TempDir(), "event_store.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	handler, err := NewEventHandler(nil, store, Counter.CounterMetaData.ABI, []common.Address{testContract}, []string{"changeCount"}, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	release := make(chan struct{})
+	calls := make(chan struct{}, 10)
+	var rollbacks atomic.Int32
+	err = handler.Handle("changeCount", func(*DecodedEvent) error {
+		calls <- struct{}{}
+		<-release
+		return nil
+	}, func(*DecodedEvent) error {
+		rollbacks.Add(1)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw := newTestLog(t, common.HexToHash("0xfe"), 0, 1)
+	key := logKey(raw)
+	if err := handler.saveAndProcessLog(raw); err != nil {
+		t.Fatal(err)
+	}
+	<-calls
+
+	// 处理回调执行期间检测到重组：回滚推迟，否则处理完成后事件会被标记为已处理而不调用补偿回调
+	stale, err := store.Get(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := handler.rollbackRecord(stale); !errors.Is(err, errRollbackDeferred) {
+		t.Fatalf("rollback during processing: err = %v, want errRollbackDeferred", err)
+	}
+	if record, _ := store.Get(key); record.Confirm == ConfirmRemoved {
+		t.Fatal("event marked removed while its callback was running")
+	}
+	close(release)
+	waitProcessed(t, store, 1)
+
+	// 下一轮用处理前读取的记录回滚：按最新状态调用一次补偿回调
+	if err := handler.rollbackRecord(stale); err != nil {
+		t.Fatal(err)
+	}
+	record, err := store.Get(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Confirm != ConfirmRemoved || record.Status != EventStatusProcessed || rollbacks.Load() != 1 {
+		t.Errorf("after rollback: confirm %v, status %v, %d rollbacks", record.Confirm, record.Status, rollbacks.Load())
+	}
+	if err := handler.rollbackRecord(stale); err != nil || rollbacks.Load() != 1 {
+		t.Errorf("second rollback: err %v, %d rollbacks", err, rollbacks.Load())
+	}
+
+	// 重试循环按过时的记录再次提交已回滚的事件，不会再调用处理回调
+	event, err := handler.decodeLog(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler.submit(key, event)
+	select {
+	case <-calls:
+		t.Error("callback ran for an event that was rolled back")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// fakeChainClient 只支持轮询的链客户端，FilterLogs 按区块范围和合约地址返回预先设置的日志
+type fakeChainClient struct {
+	mu   sync.Mutex
+	head uint64
+	logs []types.Log
+}
+
+func (c *fakeChainClient) BlockNumber(context.Context) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.head, nil
+}
+
+func (c *fakeChainClient) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *fakeChainClient) FilterLogs(_ context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var logs []types.Log
+	for _, raw := range c.logs {
+		if raw.BlockNumber < query.FromBlock.Uint64() || raw.BlockNumber > query.ToBlock.Uint64() {
+			continue
+		}
+		if len(query.Addresses) > 0 && !slices.Contains(query.Addresses, raw.Address) {
+			continue
+		}
+		logs = append(logs, raw)
+	}
+	return logs, nil
+}
+
+func (c *fakeChainClient) SubscribeFilterLogs(context.Context, ethereum.FilterQuery, chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, errors.New("not supported")
+}
+
+func TestStopDrainsInflightEventsAndFlushesStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "event_store.log")
+	store, err := NewLogEventStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	client := &fakeChainClient{head: 9138575}
+	for i := 0; i < 3; i++ {
+		client.logs = append(client.logs, newTestLog(t, common.BigToHash(big.NewInt(int64(i+1))), uint(i), int64(i+1)))
+	}
+
+	handler, err := NewEventHandler(client, store, Counter.CounterMetaData.ABI, []common.Address{testContract}, []string{"changeCount"}, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler.SetStartBlock(9138570)
+	handler.SetWorkers(1, 10)
+
+	started := make(chan struct{}, 10)
+	release := make(chan struct{})
+	err = handler.Handle("changeCount", func(*DecodedEvent) error {
+		started <- struct{}{}
+		<-release
+		return nil
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := handler.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := handler.Start(ctx); !errors.Is(err, ErrHandlerStarted) {
+		t.Errorf("second Start = %v, want ErrHandlerStarted", err)
+	}
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the first event")
+	}
+
+	stopped := make(chan error, 1)
+	go func() { stopped <- handler.Stop() }()
+
+	// 回调仍在执行时 Stop 不应返回
+	select {
+	case <-stopped:
+		t.Fatal("Stop returned before in-flight events finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case err := <-stopped:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stop did not return after callbacks finished")
+	}
+
+	// 已进入队列的事件全部处理完成，检查点已推进到最新区块
+	processed, err := store.ListByStatus(EventStatusProcessed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(processed) != 3 {
+		t.Fatalf("got %d processed events after Stop, want 3", len(processed))
+	}
+	if block, ok, _ := store.LoadBlockCheckpoint(); !ok || block != client.head {
+		t.Fatalf("checkpoint = %d (ok=%v), want %d", block, ok, client.head)
+	}
+	if err := handler.Stop(); err != nil {
+		t.Errorf("second Stop = %v", err)
+	}
+}
+
+func TestAddAddressesRewindsCheckpoint(t *testing.T) {
+	store, err := NewLogEventStore(filepath.Join(t.TempDir(), "event_store.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	handler := newTestHandler(t, store, newRecordingCallback())
+	handler.saveCheckpoint(120)
+	query, version := handler.currentFilter()
+
+	// 新合约在区块100创建：从区块100开始按新的过滤条件重新扫描，进行中的补齐结果被丢弃
+	other := common.HexToAddress("0x00000000000000000000000000000000000a0c71")
+	_, rewinds := handler.nextBlock()
+	if err := handler.AddAddresses([]common.Address{other, testContract}, 100); err != nil {
+		t.Fatal(err)
+	}
+	next, newRewinds := handler.nextBlock()
+	if next != 100 || newRewinds == rewinds {
+		t.Errorf("next block = %d (rewinds %d -> %d), want 100 and a new rewind", next, rewinds, newRewinds)
+	}
+	if block, _, _ := store.LoadBlockCheckpoint(); block != 99 {
+		t.Errorf("stored checkpoint = %d, want 99", block)
+	}
+	if got := handler.filterQuery().Addresses; len(got) != 2 || got[1] != other {
+		t.Errorf("filter addresses = %v", got)
+	}
+	if len(query.Addresses) != 1 {
+		t.Errorf("earlier filter was modified: %v", query.Addresses)
+	}
+	select {
+	case <-handler.resubscribe:
+	default:
+		t.Error("websocket subscription was not asked to resubscribe")
+	}
+
+	// 按旧过滤条件建立的订阅不能推进检查点
+	handler.saveSubscriptionCheckpoint(130, version)
+	if next, _ := handler.nextBlock(); next != 100 {
+		t.Errorf("stale subscription advanced checkpoint to %d", next-1)
+	}
+
+	// 已监听的地址不再回退检查点
+	handler.saveCheckpoint(130)
+	if err := handler.AddAddresses([]common.Address{other}, 100); err != nil {
+		t.Fatal(err)
+	}
+	if next, _ := handler.nextBlock(); next != 131 {
+		t.Errorf("next block = %d after re-adding a watched address, want 131", next)
+	}
+
+	all, err := NewEventHandler(nil, store, Counter.CounterMetaData.ABI, nil, nil, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := all.AddAddresses([]common.Address{other}, 100); err == nil {
+		t.Error("AddAddresses on a handler watching all addresses succeeded")
+	}
+}
+
+// countingStore 记录写入区块检查点的次数
+type countingStore struct {
+	EventStore
+	checkpoints []uint64
+}
+
+func (s *countingStore) SaveBlockCheckpoint(block uint64) error {
+	s.checkpoints = append(s.checkpoints, block)
+	return s.EventStore.SaveBlockCheckpoint(block)
+}
+
+func TestSubscriptionSavesCheckpointOncePerBlock(t *testing.T) {
+	logStore, err := NewLogEventStore(filepath.Join(t.TempDir(), "event_store.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer logStore.Close()
+	store := &countingStore{EventStore: logStore}
+	handler := newTestHandler(t, store, newRecordingCallback())
+	_, version := handler.currentFilter()
+
+	// 区块10有三条日志、区块11有两条：每个区块只在收到第一条日志时写一次检查点
+	logChan := make(chan types.Log)
+	sub := event.NewSubscription(func(quit <-chan struct{}) error { <-quit; return nil })
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.handleEventSubscription(ctx, cancel, sub, logChan, version)
+	}()
+	for i, block := range []uint64{10, 10, 10, 11, 11} {
+		raw := newTestLog(t, common.HexToHash("0xdd"), uint(i), int64(i+1))
+		raw.BlockNumber = block
+		logChan <- raw
+	}
+	cancel()
+	<-done
+
+	if !slices.Equal(store.checkpoints, []uint64{9, 10}) {
+		t.Errorf("checkpoints written = %v, want [9 10]", store.checkpoints)
+	}
+}
+
+func TestMetricsAndHealthEndpoints(t *testing.T) {
+	store, err := NewLogEventStore(filepath.Join(t.TempDir(), "event_store.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	callback := newRecordingCallback()
+	handler := newTestHandler(t, store, callback)
+	handler.SetMaxHealthyLag(10)
+	server := httptest.NewServer(handler.MetricsHandler())
+	defer server.Close()
+
+	get := func(path string) (int, string) {
+		t.Helper()
+		resp, err := http.Get(server.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, string(body)
+	}
+
+	// 还没有观察到最新区块时不健康
+	if code, _ := get("/healthz"); code != http.StatusServiceUnavailable {
+		t.Errorf("healthz before first head = %d, want 503", code)
+	}
+
+	raw := newTestLog(t, common.HexToHash("0xab"), 0, 1)
+	if err := handler.saveAndProcessLog(raw); err != nil {
+		t.Fatal(err)
+	}
+	waitProcessed(t, store, 1)
+	handler.saveCheckpoint(raw.BlockNumber)
+
+	handler.metrics.observeHead(raw.BlockNumber + 5)
+	code, body := get("/healthz")
+	if code != http.StatusOK {
+		t.Errorf("healthz with lag 5 = %d %s, want 200", code, body)
+	}
+
+	handler.metrics.observeHead(raw.BlockNumber + 20)
+	code, body = get("/healthz")
+	if code != http.StatusServiceUnavailable {
+		t.Errorf("healthz with lag 20 = %d %s, want 503", code, body)
+	}
+	var health HealthStatus
+	if err := json.Unmarshal([]byte(body), &health); err != nil {
+		t.Fatal(err)
+	}
+	if health.Lag != 20 || health.Healthy {
+		t.Errorf("health = %+v", health)
+	}
+
+	_, metrics := get("/metrics")
+	for _, want := range []string{
+		`event_handler_events{status="processed"} 1`,
+		`event_handler_events{status="failed"} 0`,
+		"event_handler_head_block 9138593",
+		"event_handler_checkpoint_block 9138573",
+		"event_handler_last_processed_block 9138573",
+		"event_handler_lag_blocks 20",
+		"event_handler_reconnects_total 0",
+		"event_handler_processed_total 1",
+		`event_handler_processing_seconds_bucket{le="+Inf"} 1`,
+		"event_handler_processing_seconds_count 1",
+	} {
+		if !strings.Contains(metrics, want) {
+			t.Errorf("metrics missing %q:\n%s", want, metrics)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// ErrHandlerStarted 表示事件处理器已经启动过，每个处理器只能启动一次
+var ErrHandlerStarted = errors.New("event handler already started")
+
+// Start 启动事件监听、确认循环和重试循环，ctx 取消或调用 Stop 时停止
+func (h *EventHandler) Start(ctx context.Context) error {
+	h.lifecycleMutex.Lock()
+	defer h.lifecycleMutex.Unlock()
+
+	if h.cancel != nil {
+		return ErrHandlerStarted
+	}
+	h.ctx, h.cancel = context.WithCancel(ctx)
+
+	h.goLoop(h.startRetryLoop)
+	h.goLoop(h.startConfirmLoop)
+	h.goLoop(h.listen)
+	return nil
+}
+
+// StartListening 开始监听事件，等价于 Start(context.Background())
+func (h *EventHandler) StartListening() {
+	if err := h.Start(context.Background()); err != nil {
+		log.Printf("启动事件处理器失败: %v", err)
+	}
+}
+
+// Stop 停止事件处理器：取消订阅和所有后台循环，等待队列中和正在处理的事件完成，最后将存储落盘
+// 未启动时也可以调用，用于等待直接提交的事件处理完成
+func (h *EventHandler) Stop() error {
+	h.lifecycleMutex.Lock()
+	defer h.lifecycleMutex.Unlock()
+
+	if h.stopped {
+		return nil
+	}
+	h.stopped = true
+
+	// 先取消运行上下文，让因队列已满而阻塞的提交返回；获取写锁设置 stopping 时进行中的提交都已完成，
+	// 之后的提交直接返回，因此关闭工作队列时不会有事件发送到已关闭的队列
+	if h.cancel != nil {
+		h.cancel()
+	}
+	h.queueMutex.Lock()
+	h.stopping = true
+	h.queueMutex.Unlock()
+	h.loops.Wait()
+
+	h.workerOnce.Do(func() {})
+	for _, queue := range h.workerQueues {
+		close(queue)
+	}
+	h.workers.Wait()
+
+	log.Println("事件处理器已停止")
+	return h.store.Checkpoint()
+}
+
+// goLoop 在后台运行一个随处理器停止而退出的循环
+func (h *EventHandler) goLoop(loop func(ctx context.Context)) {
+	h.loops.Add(1)
+	go func() {
+		defer h.loops.Done()
+		loop(h.ctx)
+	}()
+}
+
+// done 返回处理器停止时关闭的通道，未启动时返回 nil（永远不会关闭）
+func (h *EventHandler) done() <-chan struct{} {
+	if h.ctx == nil {
+		return nil
+	}
+	return h.ctx.Done()
+}
+
+// sleepContext 等待一段时间，ctx 被取消时提前返回 false
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
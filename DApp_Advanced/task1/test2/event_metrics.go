@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// defaultMaxHealthyLag 区块检查点落后最新区块超过该区块数时 /healthz 报告不健康
+const defaultMaxHealthyLag uint64 = 50
+
+// processingBuckets 事件处理耗时直方图的桶上限（秒）
+var processingBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// eventStatusNames 指标中使用的事件状态名
+var eventStatusNames = map[EventStatus]string{
+	EventStatusPending:    "pending",
+	EventStatusProcessing: "processing",
+	EventStatusProcessed:  "processed",
+	EventStatusFailed:     "failed",
+	EventStatusDeadLetter: "dead_letter",
+	EventStatusDiscarded:  "discarded",
+}
+
+// handlerMetrics 事件处理器的运行指标
+type handlerMetrics struct {
+	headBlock          atomic.Uint64 // 最近一次观察到的最新区块号
+	lastProcessedBlock atomic.Uint64 // 最近处理成功的事件所在的最高区块号
+	reconnects         atomic.Uint64 // WebSocket重连成功的次数
+	processed          atomic.Uint64 // 回调处理成功的次数
+	failures           atomic.Uint64 // 回调处理失败的次数
+	processing         *histogram    // 回调处理耗时
+}
+
+func newHandlerMetrics() *handlerMetrics {
+	return &handlerMetrics{processing: newHistogram(processingBuckets)}
+}
+
+// observeHead 记录观察到的最新区块号
+func (m *handlerMetrics) observeHead(head uint64) {
+	m.headBlock.Store(head)
+}
+
+// observeProcessing 记录一次回调处理的耗时和结果
+func (m *handlerMetrics) observeProcessing(block uint64, elapsed time.Duration, err error) {
+	m.processing.observe(elapsed.Seconds())
+	if err != nil {
+		m.failures.Add(1)
+		return
+	}
+	m.processed.Add(1)
+	for {
+		current := m.lastProcessedBlock.Load()
+		if block <= current || m.lastProcessedBlock.CompareAndSwap(current, block) {
+			return
+		}
+	}
+}
+
+// histogram 累积直方图，格式与Prometheus的histogram一致
+type histogram struct {
+	mu      sync.Mutex
+	buckets []float64
+	counts  []uint64 // 每个桶（不累积）的样本数，最后一个为 +Inf
+	sum     float64
+	count   uint64
+}
+
+func newHistogram(buckets []float64) *histogram {
+	return &histogram{buckets: buckets, counts: make([]uint64, len(buckets)+1)}
+}
+
+func (h *histogram) observe(value float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	i := sort.SearchFloat64s(h.buckets, value)
+	h.counts[i]++
+	h.sum += value
+	h.count++
+}
+
+// write 按Prometheus文本格式输出直方图
+func (h *histogram) write(w io.Writer, name, help string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s histogram\n", name, help, name)
+	var cumulative uint64
+	for i, bound := range h.buckets {
+		cumulative += h.counts[i]
+		fmt.Fprintf(w, "%s_bucket{le=\"%s\"} %d\n", name, strconv.FormatFloat(bound, 'g', -1, 64), cumulative)
+	}
+	fmt.Fprintf(w, "%s_bucket{le=\"+Inf\"} %d\n", name, h.count)
+	fmt.Fprintf(w, "%s_sum %s\n", name, strconv.FormatFloat(h.sum, 'g', -1, 64))
+	fmt.Fprintf(w, "%s_count %d\n", name, h.count)
+}
+
+// SetMaxHealthyLag 设置 /healthz 允许的最大区块落后数
+func (h *EventHandler) SetMaxHealthyLag(blocks uint64) {
+	h.maxHealthyLag = blocks
+}
+
+// HealthStatus /healthz 返回的健康状态
+type HealthStatus struct {
+	Healthy         bool   `json:"healthy"`
+	HeadBlock       uint64 `json:"head_block"`
+	CheckpointBlock uint64 `json:"checkpoint_block"`
+	Lag             uint64 `json:"lag"`
+	MaxLag          uint64 `json:"max_lag"`
+	Reason          string `json:"reason,omitempty"`
+}
+
+// Health 根据最新区块与区块检查点的差距判断事件处理器是否健康
+func (h *EventHandler) Health() HealthStatus {
+	head := h.metrics.headBlock.Load()
+	h.checkpointMutex.Lock()
+	checkpoint, ok := h.lastBlock, h.hasCheckpoint
+	h.checkpointMutex.Unlock()
+
+	status := HealthStatus{HeadBlock: head, CheckpointBlock: checkpoint, MaxLag: h.maxHealthyLag}
+	switch {
+	case head == 0:
+		status.Reason = "latest block not observed yet"
+	case !ok:
+		status.Reason = "no block checkpoint yet"
+	default:
+		if head > checkpoint {
+			status.Lag = head - checkpoint
+		}
+		status.Healthy = status.Lag <= h.maxHealthyLag
+		if !status.Healthy {
+			status.Reason = fmt.Sprintf("checkpoint is %d blocks behind head", status.Lag)
+		}
+	}
+	return status
+}
+
+// MetricsHandler 返回提供 /metrics（Prometheus文本格式）和 /healthz 的HTTP处理器
+func (h *EventHandler) MetricsHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", h.serveMetrics)
+	mux.HandleFunc("/healthz", h.serveHealth)
+	return mux
+}
+
+// ServeMetrics 在 addr 上提供 /metrics 和 /healthz，ctx 取消时关闭HTTP服务
+func (h *EventHandler) ServeMetrics(ctx context.Context, addr string) error {
+	log.Printf("指标服务已启动: http://%s/metrics", addr)
+	return serveHTTP(ctx, addr, h.MetricsHandler())
+}
+
+// serveHTTP 在 addr 上提供 handler，ctx 取消时关闭HTTP服务
+func serveHTTP(ctx context.Context, addr string, handler http.Handler) error {
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("serve metrics: %w", err)
+	}
+	return nil
+}
+
+func (h *EventHandler) serveHealth(w http.ResponseWriter, _ *http.Request) {
+	status := h.Health()
+	w.Header().Set("Content-Type", "application/json")
+	if !status.Healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(status)
+}
+
+func (h *EventHandler) serveMetrics(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	// 各状态的事件数由存储在状态变化时维护，抓取指标时不读取事件记录
+	fmt.Fprintln(w, "# HELP event_handler_events Number of stored events by processing status.")
+	fmt.Fprintln(w, "# TYPE event_handler_events gauge")
+	if counts, err := h.store.CountByStatus(); err != nil {
+		log.Printf("读取事件数量失败: %v", err)
+	} else {
+		for _, status := range allEventStatuses {
+			fmt.Fprintf(w, "event_handler_events{status=%q} %d\n", eventStatusNames[status], counts[status])
+		}
+	}
+
+	health := h.Health()
+	writeMetric(w, "event_handler_head_block", "gauge", "Latest block number observed from the node.", health.HeadBlock)
+	writeMetric(w, "event_handler_checkpoint_block", "gauge", "Last block fully scanned for events.", health.CheckpointBlock)
+	writeMetric(w, "event_handler_last_processed_block", "gauge", "Highest block of a successfully processed event.", h.metrics.lastProcessedBlock.Load())
+	writeMetric(w, "event_handler_lag_blocks", "gauge", "Blocks between the latest block and the checkpoint.", health.Lag)
+	writeMetric(w, "event_handler_reconnects_total", "counter", "Successful WebSocket reconnects.", h.metrics.reconnects.Load())
+	writeMetric(w, "event_handler_processed_total", "counter", "Event callbacks that succeeded.", h.metrics.processed.Load())
+	writeMetric(w, "event_handler_failures_total", "counter", "Event callbacks that returned an error.", h.metrics.failures.Load())
+	h.metrics.processing.write(w, "event_handler_processing_seconds", "Time spent in event callbacks.")
+}
+
+// writeMetric 按Prometheus文本格式输出一个没有标签的指标
+func writeMetric(w io.Writer, name, kind, help string, value uint64) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n%s %d\n", name, help, name, kind, name, value)
+}
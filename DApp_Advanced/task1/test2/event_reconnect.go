@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+)
+
+// WebSocketDialer 建立新的WebSocket客户端连接，通常为对配置的WebSocket端点调用 ethclient.DialContext
+type WebSocketDialer func(ctx context.Context) (ChainClient, error)
+
+// 重连参数
+const (
+	maxReconnectAttempts = 5                // 单次重连的最大尝试次数，超过后回退到轮询
+	reconnectBaseDelay   = time.Second      // 第一次重连前的等待时间
+	reconnectMaxDelay    = 30 * time.Second // 指数退避的最大等待时间
+	probeTimeout         = 5 * time.Second  // 健康检查的超时时间
+	wsProbeInterval      = time.Minute      // 轮询期间尝试恢复WebSocket的间隔
+)
+
+// SetFallbackClient 设置WebSocket不可用时轮询使用的客户端（通常为HTTP客户端）
+func (h *EventHandler) SetFallbackClient(client ChainClient) {
+	h.fallbackClient = client
+}
+
+// getClient 返回当前使用的客户端
+func (h *EventHandler) getClient() ChainClient {
+	h.clientMutex.RLock()
+	defer h.clientMutex.RUnlock()
+
+	return h.client
+}
+
+// setClient 替换当前使用的客户端，旧的WebSocket客户端会被关闭
+func (h *EventHandler) setClient(client ChainClient) {
+	h.clientMutex.Lock()
+	old := h.client
+	h.client = client
+	h.clientMutex.Unlock()
+
+	if old != nil && old != client && old != h.fallbackClient {
+		if closer, ok := old.(interface{ Close() }); ok {
+			closer.Close()
+		}
+	}
+}
+
+// reconnect 使用带随机抖动的指数退避重新建立WebSocket连接，
+// 新连接通过健康检查后替换当前客户端，所有尝试都失败时返回错误
+func (h *EventHandler) reconnect(ctx context.Context) error {
+	if h.dialWebSocket == nil {
+		return errors.New("no websocket dialer configured")
+	}
+
+	log.Println("尝试重新连接WebSocket...")
+	for attempt := 1; attempt <= maxReconnectAttempts; attempt++ {
+		delay := backoffDelay(attempt)
+		log.Printf("重连尝试 #%d/%d，%v后开始...", attempt, maxReconnectAttempts, delay.Round(time.Millisecond))
+		if !sleepContext(ctx, delay) {
+			return ctx.Err()
+		}
+
+		client, err := h.dialAndProbe(ctx)
+		if err != nil {
+			log.Printf("重连失败: %v", err)
+			continue
+		}
+
+		log.Println("WebSocket重连成功！")
+		h.setClient(client)
+		h.metrics.reconnects.Add(1)
+		return nil
+	}
+
+	return fmt.Errorf("failed to reconnect after %d attempts", maxReconnectAttempts)
+}
+
+// dialAndProbe 建立新的WebSocket连接并进行健康检查
+func (h *EventHandler) dialAndProbe(parent context.Context) (ChainClient, error) {
+	ctx, cancel := context.WithTimeout(parent, probeTimeout)
+	defer cancel()
+
+	client, err := h.dialWebSocket(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("dial websocket: %w", err)
+	}
+
+	// 健康检查：能够正常返回最新区块号才认为连接可用
+	if _, err := client.BlockNumber(ctx); err != nil {
+		if closer, ok := client.(interface{ Close() }); ok {
+			closer.Close()
+		}
+		return nil, fmt.Errorf("health probe: %w", err)
+	}
+	return client, nil
+}
+
+// backoffDelay 计算第 attempt 次重连前的等待时间：指数增长并限制上限，再乘以 [0.5, 1.5) 的随机抖动，
+// 避免多个实例在节点恢复时同时重连
+func backoffDelay(attempt int) time.Duration {
+	delay := reconnectBaseDelay << (attempt - 1)
+	if delay > reconnectMaxDelay || delay <= 0 {
+		delay = reconnectMaxDelay
+	}
+	jitter := 0.5 + rand.Float64()
+	return time.Duration(float64(delay) * jitter)
+}
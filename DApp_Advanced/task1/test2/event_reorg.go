@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// defaultFinalityDepth 默认的最终确认深度（约两个epoch），超过该深度的区块不再检查重组
+const defaultFinalityDepth uint64 = 64
+
+// defaultPollInterval 默认的轮询和确认检查间隔
+const defaultPollInterval = 5 * time.Second
+
+// errRollbackDeferred 事件在队列中或正在处理，本轮不回滚；事件处理完成后由确认循环下一轮检查时回滚
+var errRollbackDeferred = errors.New("event is being processed, rollback deferred to the next confirmation check")
+
+// SetConfirmations 设置事件处理前需要的区块确认数，0表示收到事件后立即处理
+func (h *EventHandler) SetConfirmations(confirmations uint64) {
+	h.confirmations = confirmations
+}
+
+// SetFinalityDepth 设置最终确认深度，不能小于确认数
+func (h *EventHandler) SetFinalityDepth(depth uint64) {
+	if depth > 0 {
+		h.finalityDepth = depth
+	}
+}
+
+// SetPollInterval 设置轮询新事件和确认循环检查区块确认数、重组的间隔，出块较快的链（例如本地开发链）可以调小
+func (h *EventHandler) SetPollInterval(interval time.Duration) {
+	if interval > 0 {
+		h.pollInterval = interval
+	}
+}
+
+// startConfirmLoop 启动确认循环，推进事件的 待确认->已确认->最终确认 状态并检测重组，ctx 取消时退出
+func (h *EventHandler) startConfirmLoop(ctx context.Context) {
+	for {
+		h.checkConfirmations(ctx)
+		if !sleepContext(ctx, h.pollInterval) {
+			return
+		}
+	}
+}
+
+// checkConfirmations 检查所有未最终确认的事件：
+// 确认数达到 confirmations 且区块哈希仍在主链上的事件交给回调处理，
+// 区块哈希与主链不一致的事件执行回滚，确认数达到 finalityDepth 的事件标记为最终确认
+func (h *EventHandler) checkConfirmations(ctx context.Context) {
+	head, err := h.getClient().BlockNumber(ctx)
+	if err != nil {
+		log.Printf("获取最新区块号失败: %v", err)
+		return
+	}
+	h.metrics.observeHead(head)
+
+	// 同一轮检查中缓存每个区块号对应的主链区块哈希
+	canonical := make(map[uint64]string)
+	canonicalHash := func(number uint64) (string, error) {
+		if hash, ok := canonical[number]; ok {
+			return hash, nil
+		}
+		header, err := h.getClient().HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return "", err
+		}
+		canonical[number] = header.Hash().Hex()
+		return canonical[number], nil
+	}
+
+	// 只读取尚未最终确认的事件，已处理和最终确认的记录会一直累积，不需要每轮遍历
+	records, err := h.store.ListUnfinalized()
+	if err != nil {
+		log.Printf("读取未最终确认的事件失败: %v", err)
+		return
+	}
+
+	for _, record := range records {
+		// 节点落后于事件所在区块（例如切换了节点），等待下一轮
+		if record.BlockNumber > head {
+			continue
+		}
+
+		depth := head - record.BlockNumber + 1
+		if record.Confirm == ConfirmPending && depth < h.confirmations {
+			continue
+		}
+
+		hash, err := canonicalHash(record.BlockNumber)
+		if err != nil {
+			log.Printf("获取区块 %d 失败: %v", record.BlockNumber, err)
+			continue
+		}
+		if record.BlockHash != "" && hash != record.BlockHash {
+			log.Printf("检测到链重组: 区块 %d 的哈希由 %s 变为 %s", record.BlockNumber, record.BlockHash, hash)
+			if err := h.rollbackRecord(record); err != nil {
+				log.Printf("回滚事件失败 %s: %v", record.Key(), err)
+			}
+			continue
+		}
+
+		switch {
+		case record.Confirm == ConfirmPending:
+			h.confirmRecord(record)
+		case depth >= h.finalityDepth:
+			if err := h.store.UpdateStatus(record.Key(), record.Status, func(r *EventRecord) {
+				r.Confirm = ConfirmFinalized
+			}); err != nil {
+				log.Printf("更新事件状态失败 %s: %v", record.Key(), err)
+			}
+		}
+	}
+}
+
+// confirmRecord 将达到确认深度的事件标记为已确认并交给回调处理
+func (h *EventHandler) confirmRecord(record *EventRecord) {
+	event, err := h.decodeRecord(record)
+	if err != nil {
+		log.Printf("解析事件数据失败 %s: %v", record.Key(), err)
+		return
+	}
+
+	err = h.store.UpdateStatus(record.Key(), record.Status, func(r *EventRecord) {
+		r.Confirm = ConfirmConfirmed
+	})
+	if err != nil {
+		log.Printf("更新事件状态失败 %s: %v", record.Key(), err)
+		return
+	}
+
+	h.submit(record.Key(), event)
+}
+
+// handleRemovedLog 处理订阅推送的 Removed=true 日志
+func (h *EventHandler) handleRemovedLog(raw types.Log) {
+	record, err := h.store.Get(logKey(raw))
+	if err != nil || record.Confirm == ConfirmRemoved {
+		return
+	}
+
+	log.Printf("事件所在区块已被重组移除: %s (区块 %d)", record.Key(), record.BlockNumber)
+	if err := h.rollbackRecord(record); err != nil {
+		log.Printf("回滚事件失败 %s: %v", record.Key(), err)
+	}
+}
+
+// rollbackRecord 将事件标记为已移除；已处理的事件会先调用补偿回调，
+// 并把区块检查点回退到该区块之前，以便重新扫描新链上的事件。
+// 事件在队列中或正在处理时返回 errRollbackDeferred，避免回滚后处理回调又把事件标记为已处理而漏掉补偿；
+// 回滚期间占用事件，重试循环不会再提交它
+func (h *EventHandler) rollbackRecord(record *EventRecord) error {
+	key := record.Key()
+	if !h.claim(key) {
+		return errRollbackDeferred
+	}
+	defer h.release(key)
+
+	// 调用方读取的记录可能已经过时，按占用后的最新状态决定是否需要补偿
+	current, err := h.store.Get(key)
+	if err != nil {
+		return err
+	}
+	if current.Confirm == ConfirmRemoved {
+		return nil
+	}
+	if current.Status == EventStatusProcessed {
+		event, err := h.decodeRecord(current)
+		if err != nil {
+			return fmt.Errorf("decode event data: %w", err)
+		}
+		if err := h.dispatchRollback(event); err != nil {
+			return fmt.Errorf("rollback callback: %w", err)
+		}
+	}
+
+	err = h.store.UpdateStatus(key, current.Status, func(r *EventRecord) {
+		r.Confirm = ConfirmRemoved
+	})
+	if err != nil {
+		return err
+	}
+
+	if current.BlockNumber > 0 {
+		h.rewindCheckpoint(current.BlockNumber - 1)
+	}
+	log.Printf("事件已回滚: %s", key)
+	return nil
+}
@@ -0,0 +1,370 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrEventNotFound 表示存储中不存在指定的事件记录
+var ErrEventNotFound = errors.New("event record not found")
+
+// EventStore 事件记录的持久化存储接口
+// 所有实现都必须是并发安全的，返回的记录均为副本，修改副本不会影响存储中的数据
+type EventStore interface {
+	// Get 根据键读取事件记录，不存在时返回 ErrEventNotFound
+	Get(key string) (*EventRecord, error)
+	// Put 写入（或覆盖）一条事件记录
+	Put(record *EventRecord) error
+	// UpdateStatus 更新事件状态，mutate 不为 nil 时可以在同一次写入中修改记录的其他字段
+	UpdateStatus(key string, status EventStatus, mutate func(record *EventRecord)) error
+	// ListByStatus 按区块号顺序列出指定状态的事件记录
+	ListByStatus(status EventStatus) ([]*EventRecord, error)
+	// ListUnfinalized 按区块号顺序列出待确认和已确认（尚未最终确认）的事件记录，供确认循环检查确认数和重组
+	ListUnfinalized() ([]*EventRecord, error)
+	// CountByStatus 返回每种状态的事件数量，由写入时维护的索引得出，不遍历记录
+	CountByStatus() (map[EventStatus]int, error)
+	// LoadBlockCheckpoint 读取最后一个已完整处理的区块号，ok 为 false 表示尚未记录
+	LoadBlockCheckpoint() (block uint64, ok bool, err error)
+	// SaveBlockCheckpoint 记录最后一个已完整处理的区块号
+	SaveBlockCheckpoint(block uint64) error
+	// Checkpoint 将当前数据整理并落盘，用于压缩日志或在退出前刷新数据
+	Checkpoint() error
+	// Close 关闭存储并释放文件句柄
+	Close() error
+}
+
+// 存储后端类型
+const (
+	StoreBackendLog  = "log"  // 追加写日志（默认）
+	StoreBackendJSON = "json" // 旧版单个JSON文件，每次修改都会整体重写
+)
+
+// OpenEventStore 根据后端类型打开事件存储
+func OpenEventStore(backend, path string) (EventStore, error) {
+	switch backend {
+	case StoreBackendLog, "":
+		return NewLogEventStore(path)
+	case StoreBackendJSON:
+		return NewJSONEventStore(path)
+	default:
+		return nil, fmt.Errorf("unknown event store backend: %q", backend)
+	}
+}
+
+// ImportLegacyStore 将旧版 event_store.json 中的记录导入到新的存储中
+// 旧文件不存在时直接返回，已存在于目标存储的记录不会被覆盖
+func ImportLegacyStore(dst EventStore, legacyPath string) (int, error) {
+	if _, err := os.Stat(legacyPath); errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+
+	legacy, err := NewJSONEventStore(legacyPath)
+	if err != nil {
+		return 0, err
+	}
+	defer legacy.Close()
+
+	// 目标存储还没有区块检查点时沿用旧存储的检查点
+	if _, ok, err := dst.LoadBlockCheckpoint(); err == nil && !ok {
+		if block, ok, err := legacy.LoadBlockCheckpoint(); err == nil && ok {
+			if err := dst.SaveBlockCheckpoint(block); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	imported := 0
+	for _, status := range allEventStatuses {
+		records, err := legacy.ListByStatus(status)
+		if err != nil {
+			return imported, err
+		}
+		for _, record := range records {
+			if _, err := dst.Get(record.Key()); err == nil {
+				continue
+			}
+			if err := dst.Put(record); err != nil {
+				return imported, err
+			}
+			imported++
+		}
+	}
+
+	return imported, dst.Checkpoint()
+}
+
+// allEventStatuses 所有事件状态，用于遍历整个存储
+var allEventStatuses = []EventStatus{
+	EventStatusPending,
+	EventStatusProcessing,
+	EventStatusProcessed,
+	EventStatusFailed,
+	EventStatusDeadLetter,
+	EventStatusDiscarded,
+}
+
+// migrateRecord 从保存的原始日志中补全记录的日志索引和区块哈希，返回记录的键是否发生了变化
+// 旧版 event_store.json 以交易哈希为键，原始日志保存在 event_data.Raw 中，加载时迁移到 log 字段和新的键
+func migrateRecord(key string, record *EventRecord) bool {
+	if record.Log == nil && record.EventData != nil {
+		raw, err := json.Marshal(record.EventData)
+		if err == nil {
+			var data struct {
+				Raw *types.Log
+			}
+			if err := json.Unmarshal(raw, &data); err == nil && data.Raw != nil {
+				record.Log = data.Raw
+			}
+		}
+	}
+	if record.Log != nil {
+		record.TxHash = record.Log.TxHash.Hex()
+		record.LogIndex = record.Log.Index
+		record.BlockHash = record.Log.BlockHash.Hex()
+	}
+	return record.Key() != key
+}
+
+// recordIndex 按处理状态和确认状态索引事件记录，写入记录时维护，查询时不需要遍历所有记录
+// 已处理和最终确认的记录会一直累积，确认循环和重试循环只需要其中很少的一部分
+type recordIndex struct {
+	byStatus    map[EventStatus]map[string]*EventRecord
+	unfinalized map[string]*EventRecord // 待确认和已确认（尚未最终确认）的记录
+}
+
+func newRecordIndex() *recordIndex {
+	return &recordIndex{
+		byStatus:    make(map[EventStatus]map[string]*EventRecord),
+		unfinalized: make(map[string]*EventRecord),
+	}
+}
+
+// put 记录写入（或原地修改）后更新索引，record 为存储内部的记录
+func (ix *recordIndex) put(key string, record *EventRecord) {
+	for _, records := range ix.byStatus {
+		delete(records, key)
+	}
+	delete(ix.unfinalized, key)
+
+	if ix.byStatus[record.Status] == nil {
+		ix.byStatus[record.Status] = make(map[string]*EventRecord)
+	}
+	ix.byStatus[record.Status][key] = record
+	if record.Confirm == ConfirmPending || record.Confirm == ConfirmConfirmed {
+		ix.unfinalized[key] = record
+	}
+}
+
+// counts 返回每种状态的记录数
+func (ix *recordIndex) counts() map[EventStatus]int {
+	counts := make(map[EventStatus]int, len(ix.byStatus))
+	for status, records := range ix.byStatus {
+		counts[status] = len(records)
+	}
+	return counts
+}
+
+// list 返回索引中记录的副本，按区块号排序
+func (ix *recordIndex) list(records map[string]*EventRecord) []*EventRecord {
+	list := make([]*EventRecord, 0, len(records))
+	for _, record := range records {
+		list = append(list, copyRecord(record))
+	}
+	sortRecords(list)
+	return list
+}
+
+// copyRecord 复制一条事件记录，避免调用方修改存储内部的数据
+func copyRecord(record *EventRecord) *EventRecord {
+	cp := *record
+	return &cp
+}
+
+// sortRecords 按区块号、交易哈希排序，保证遍历顺序稳定
+func sortRecords(records []*EventRecord) {
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].BlockNumber != records[j].BlockNumber {
+			return records[i].BlockNumber < records[j].BlockNumber
+		}
+		return records[i].Key() < records[j].Key()
+	})
+}
+
+// blockCheckpoint 旧版存储的区块检查点文件格式
+type blockCheckpoint struct {
+	LastBlock uint64 `json:"last_block"`
+}
+
+// jsonEventStore 旧版存储：所有记录保存在一个JSON文件中，每次修改都会重写整个文件
+// 为保持文件格式兼容，区块检查点单独保存在 <path>.checkpoint 文件中
+type jsonEventStore struct {
+	mu      sync.RWMutex
+	path    string
+	records map[string]*EventRecord
+	index   *recordIndex
+}
+
+// NewJSONEventStore 打开旧版JSON文件存储，文件不存在时会创建新的
+func NewJSONEventStore(path string) (EventStore, error) {
+	s := &jsonEventStore{
+		path:    path,
+		records: make(map[string]*EventRecord),
+		index:   newRecordIndex(),
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		log.Println("事件存储文件不存在，将创建新的")
+		return s, s.save()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open event store %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var loaded map[string]*EventRecord
+	// 文件无法解析时返回错误，不能以空存储继续运行，否则下次保存会覆盖原有记录
+	if err := json.NewDecoder(file).Decode(&loaded); err != nil {
+		return nil, fmt.Errorf("decode event store %s: %w", path, err)
+	}
+
+	// 迁移以交易哈希为键的旧记录
+	migrated := 0
+	for key, record := range loaded {
+		if migrateRecord(key, record) {
+			migrated++
+		}
+		s.records[record.Key()] = record
+		s.index.put(record.Key(), record)
+	}
+	if migrated > 0 {
+		log.Printf("已迁移 %d 条旧格式的事件记录", migrated)
+		if err := s.save(); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+func (s *jsonEventStore) Get(key string) (*EventRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	record, ok := s.records[key]
+	if !ok {
+		return nil, ErrEventNotFound
+	}
+	return copyRecord(record), nil
+}
+
+func (s *jsonEventStore) Put(record *EventRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := record.Key()
+	s.records[key] = copyRecord(record)
+	s.index.put(key, s.records[key])
+	return s.save()
+}
+
+func (s *jsonEventStore) UpdateStatus(key string, status EventStatus, mutate func(record *EventRecord)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[key]
+	if !ok {
+		return ErrEventNotFound
+	}
+	record.Status = status
+	if mutate != nil {
+		mutate(record)
+	}
+	s.index.put(key, record)
+	return s.save()
+}
+
+func (s *jsonEventStore) ListByStatus(status EventStatus) ([]*EventRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.index.list(s.index.byStatus[status]), nil
+}
+
+func (s *jsonEventStore) ListUnfinalized() ([]*EventRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.index.list(s.index.unfinalized), nil
+}
+
+func (s *jsonEventStore) CountByStatus() (map[EventStatus]int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.index.counts(), nil
+}
+
+func (s *jsonEventStore) LoadBlockCheckpoint() (uint64, bool, error) {
+	data, err := os.ReadFile(s.path + ".checkpoint")
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("read block checkpoint: %w", err)
+	}
+
+	var checkpoint blockCheckpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return 0, false, fmt.Errorf("decode block checkpoint: %w", err)
+	}
+	return checkpoint.LastBlock, true, nil
+}
+
+func (s *jsonEventStore) SaveBlockCheckpoint(block uint64) error {
+	data, err := json.Marshal(blockCheckpoint{LastBlock: block})
+	if err != nil {
+		return err
+	}
+
+	// 先写临时文件再rename，避免写到一半时崩溃导致检查点损坏
+	tmpPath := s.path + ".checkpoint.tmp"
+	if err := os.WriteFile(tmpPath, data, 0o644); err != nil {
+		return fmt.Errorf("write block checkpoint: %w", err)
+	}
+	return os.Rename(tmpPath, s.path+".checkpoint")
+}
+
+func (s *jsonEventStore) Checkpoint() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.save()
+}
+
+// Close 每次修改都已写入文件，这里无需额外操作
+func (s *jsonEventStore) Close() error {
+	return nil
+}
+
+// save 将所有记录写入文件，调用方必须持有锁
+func (s *jsonEventStore) save() error {
+	file, err := os.Create(s.path)
+	if err != nil {
+		return fmt.Errorf("无法创建事件存储文件: %w", err)
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	if err := encoder.Encode(s.records); err != nil {
+		return fmt.Errorf("保存事件存储失败: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+)
+
+// errEventStoreClosed 存储关闭后继续写入时返回
+var errEventStoreClosed = errors.New("event store is closed")
+
+// 日志条目的操作类型
+const (
+	logOpPut        = "put"
+	logOpCheckpoint = "checkpoint"
+)
+
+// 追加条目数超过 活跃记录数*compactFactor 且不少于 minCompactEntries 时自动压缩日志
+const (
+	compactFactor     = 4
+	minCompactEntries = 1000
+)
+
+// logEntry 追加写日志中的一行
+type logEntry struct {
+	Op     string       `json:"op"`
+	Key    string       `json:"key,omitempty"`
+	Record *EventRecord `json:"record,omitempty"`
+	Block  uint64       `json:"block,omitempty"`
+}
+
+// logEventStore 追加写日志存储
+// 每次修改只在文件末尾追加一行JSON并fsync，启动时按顺序重放日志恢复内存索引，
+// 日志过长时通过 Checkpoint 写出快照（临时文件+rename）完成压缩，不会出现整体重写到一半的文件
+type logEventStore struct {
+	mu       sync.RWMutex
+	path     string
+	file     *os.File
+	records  map[string]*EventRecord
+	index    *recordIndex
+	appended int // 自上次压缩以来追加的条目数
+
+	lastBlock     uint64 // 最后一个已完整处理的区块号
+	hasCheckpoint bool
+}
+
+// NewLogEventStore 打开（或创建）追加写日志存储
+func NewLogEventStore(path string) (EventStore, error) {
+	s := &logEventStore{
+		path:    path,
+		records: make(map[string]*EventRecord),
+		index:   newRecordIndex(),
+	}
+
+	migrated, err := s.replay()
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open event log %s: %w", path, err)
+	}
+	s.file = file
+
+	// 日志中存在旧格式的键时立即压缩，使日志只包含新的键
+	if migrated {
+		if err := s.compact(); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// replay 重放日志文件，最后一行不完整（没有换行符，进程在写入时崩溃）时截断该行；
+// 中间的记录无法解析时返回包含偏移量的错误，不截断文件。返回日志中是否存在需要迁移的旧格式记录
+func (s *logEventStore) replay() (bool, error) {
+	file, err := os.OpenFile(s.path, os.O_RDWR, 0)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("open event log %s: %w", s.path, err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	var validOffset int64
+	migrated := false
+	for {
+		line, readErr := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			// 没有换行符结尾的行说明写入未完成，丢弃
+			if line[len(line)-1] != '\n' {
+				log.Printf("事件日志末尾存在不完整的记录，已丢弃 %d 字节", len(line))
+				break
+			}
+			// 完整写入的行无法解析说明文件已损坏，不能丢弃其后的有效记录
+			var entry logEntry
+			if err := json.Unmarshal(bytes.TrimSpace(line), &entry); err != nil {
+				return false, fmt.Errorf("event log %s is corrupt at offset %d: %w", s.path, validOffset, err)
+			}
+			if entry.Record != nil && migrateRecord(entry.Key, entry.Record) {
+				entry.Key = entry.Record.Key()
+				migrated = true
+			}
+			s.apply(&entry)
+			s.appended++
+			validOffset += int64(len(line))
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return false, fmt.Errorf("read event log %s: %w", s.path, readErr)
+		}
+	}
+
+	return migrated, file.Truncate(validOffset)
+}
+
+// apply 将一条日志条目应用到内存索引
+func (s *logEventStore) apply(entry *logEntry) {
+	switch entry.Op {
+	case logOpPut:
+		if entry.Record != nil {
+			s.records[entry.Key] = entry.Record
+			s.index.put(entry.Key, entry.Record)
+		}
+	case logOpCheckpoint:
+		s.lastBlock, s.hasCheckpoint = entry.Block, true
+	}
+}
+
+// append 追加一条日志并落盘，调用方必须持有写锁
+func (s *logEventStore) append(entry *logEntry) error {
+	if s.file == nil {
+		return errEventStoreClosed
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("encode event log entry: %w", err)
+	}
+	data = append(data, '\n')
+
+	if _, err := s.file.Write(data); err != nil {
+		return fmt.Errorf("write event log: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("sync event log: %w", err)
+	}
+
+	s.apply(entry)
+	s.appended++
+
+	if s.appended > minCompactEntries && s.appended > len(s.records)*compactFactor {
+		if err := s.compact(); err != nil {
+			log.Printf("压缩事件日志失败: %v", err)
+		}
+	}
+	return nil
+}
+
+func (s *logEventStore) Get(key string) (*EventRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	record, ok := s.records[key]
+	if !ok {
+		return nil, ErrEventNotFound
+	}
+	return copyRecord(record), nil
+}
+
+func (s *logEventStore) Put(record *EventRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := record.Key()
+	return s.append(&logEntry{Op: logOpPut, Key: key, Record: copyRecord(record)})
+}
+
+func (s *logEventStore) UpdateStatus(key string, status EventStatus, mutate func(record *EventRecord)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, ok := s.records[key]
+	if !ok {
+		return ErrEventNotFound
+	}
+
+	record := copyRecord(current)
+	record.Status = status
+	if mutate != nil {
+		mutate(record)
+	}
+	return s.append(&logEntry{Op: logOpPut, Key: key, Record: record})
+}
+
+func (s *logEventStore) ListByStatus(status EventStatus) ([]*EventRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.index.list(s.index.byStatus[status]), nil
+}
+
+func (s *logEventStore) ListUnfinalized() ([]*EventRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.index.list(s.index.unfinalized), nil
+}
+
+func (s *logEventStore) CountByStatus() (map[EventStatus]int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.index.counts(), nil
+}
+
+func (s *logEventStore) LoadBlockCheckpoint() (uint64, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.lastBlock, s.hasCheckpoint, nil
+}
+
+func (s *logEventStore) SaveBlockCheckpoint(block uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.append(&logEntry{Op: logOpCheckpoint, Block: block})
+}
+
+func (s *logEventStore) Checkpoint() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.compact()
+}
+
+func (s *logEventStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+// compact 将当前所有记录写成新的日志快照，替换旧日志，调用方必须持有写锁
+func (s *logEventStore) compact() error {
+	tmpPath := s.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return fmt.Errorf("create event log snapshot: %w", err)
+	}
+
+	records := make([]*EventRecord, 0, len(s.records))
+	for _, record := range s.records {
+		records = append(records, record)
+	}
+	sortRecords(records)
+
+	entries := make([]*logEntry, 0, len(records)+1)
+	for _, record := range records {
+		entries = append(entries, &logEntry{Op: logOpPut, Key: record.Key(), Record: record})
+	}
+	if s.hasCheckpoint {
+		entries = append(entries, &logEntry{Op: logOpCheckpoint, Block: s.lastBlock})
+	}
+
+	writer := bufio.NewWriter(tmp)
+	encoder := json.NewEncoder(writer)
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			tmp.Close()
+			return fmt.Errorf("write event log snapshot: %w", err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("flush event log snapshot: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("sync event log snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close event log snapshot: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("replace event log: %w", err)
+	}
+	if s.file != nil {
+		s.file.Close()
+	}
+
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("reopen event log: %w", err)
+	}
+	s.file = file
+	s.appended = len(entries)
+
+	return nil
+}
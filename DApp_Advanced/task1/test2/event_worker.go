@@ -0,0 +1,92 @@
+package main
+
+// 默认工作池配置
+const (
+	defaultWorkerCount     = 4   // 处理事件的工作协程数
+	defaultWorkerQueueSize = 100 // 每个工作协程的待处理队列长度
+)
+
+// processJob 交给工作池处理的事件
+type processJob struct {
+	key   string
+	event *DecodedEvent
+}
+
+// SetWorkers 设置处理事件的工作协程数和每个工作协程的队列长度，需要在开始处理事件之前调用
+// 队列已满时提交事件会阻塞，订阅和补齐因此会暂停读取新的日志（背压），而不会无限制地创建协程
+func (h *EventHandler) SetWorkers(workers, queueSize int) {
+	if workers < 1 {
+		workers = 1
+	}
+	if queueSize < 0 {
+		queueSize = 0
+	}
+	h.workerCount = workers
+	h.workerQueueSize = queueSize
+}
+
+// startWorkers 启动工作协程，只在第一次提交事件时执行一次
+func (h *EventHandler) startWorkers() {
+	h.workerQueues = make([]chan processJob, h.workerCount)
+	for i := range h.workerQueues {
+		queue := make(chan processJob, h.workerQueueSize)
+		h.workerQueues[i] = queue
+		h.workers.Add(1)
+		go h.runWorker(queue)
+	}
+}
+
+// runWorker 按提交顺序依次处理队列中的事件
+func (h *EventHandler) runWorker(queue <-chan processJob) {
+	defer h.workers.Done()
+
+	for job := range queue {
+		h.processEvent(job.key, job.event)
+		h.release(job.key)
+	}
+}
+
+// claim 占用事件，已在队列中、正在处理或正在回滚时返回 false
+// 处理和回滚都要先占用事件，保证同一事件的处理回调和补偿回调不会交错执行
+func (h *EventHandler) claim(key string) bool {
+	h.inflightMutex.Lock()
+	defer h.inflightMutex.Unlock()
+	if _, ok := h.inflight[key]; ok {
+		return false
+	}
+	h.inflight[key] = struct{}{}
+	return true
+}
+
+// release 释放 claim 占用的事件
+func (h *EventHandler) release(key string) {
+	h.inflightMutex.Lock()
+	delete(h.inflight, key)
+	h.inflightMutex.Unlock()
+}
+
+// submit 将事件交给工作池处理，同一区块的事件总是分配给同一个工作协程，按提交顺序处理
+// 事件已在队列中、正在处理或正在回滚时直接返回 false，保证同一事件的回调不会并发执行
+// 处理器停止后不再接收新的事件，未提交的事件保留在存储中，下次启动时重新处理
+func (h *EventHandler) submit(key string, event *DecodedEvent) bool {
+	// 持有读锁直到事件放入队列，Stop 关闭队列前需要获取写锁，不会向已关闭的队列发送事件
+	h.queueMutex.RLock()
+	defer h.queueMutex.RUnlock()
+	if h.stopping {
+		return false
+	}
+
+	if !h.claim(key) {
+		return false
+	}
+
+	h.workerOnce.Do(h.startWorkers)
+	queue := h.workerQueues[event.Raw.BlockNumber%uint64(len(h.workerQueues))]
+	select {
+	case queue <- processJob{key: key, event: event}:
+		return true
+	case <-h.done():
+		h.release(key)
+		return false
+	}
+}
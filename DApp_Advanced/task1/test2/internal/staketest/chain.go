@@ -0,0 +1,122 @@
+// Package staketest 提供质押客户端和质押命令测试共用的模拟节点
+package staketest
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	Stake "counter/stake" // 别名导入，使用首字母大写的包名
+	"ethtx"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// 模拟链上的合约地址
+var (
+	StakeAddress    = common.HexToAddress("0x0F2bbDaF836b2A6Fb90a5E83A7F5ebC59F2Af92f")
+	MetaNodeAddress = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	USDTAddress     = common.HexToAddress("0x00000000000000000000000000000000000000a2")
+)
+
+// PoolState 模拟的质押池和账户在其中的持仓
+type PoolState struct {
+	Token                             common.Address
+	MinDeposit, LockedBlocks          int64
+	Staked, Pending, Requested, Ready *big.Int
+}
+
+// backend 模拟节点没有实现的方法，测试中不会被调用
+type backend interface {
+	bind.ContractBackend
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// Chain 按ABI应答 MetaNodeStake 和 ERC-20 合约调用的模拟节点，只有一个账户有持仓
+// 这里没有合约字节码，无法部署到模拟后端；未实现的方法不会被调用
+type Chain struct {
+	backend
+	Block      uint64
+	Pools      []PoolState
+	CallBlocks []*big.Int // 每次调用质押合约时的区块号
+}
+
+// NewChain 创建有三个质押池的模拟节点：ETH质押池、有持仓的USDT质押池和没有持仓的USDT质押池
+func NewChain() *Chain {
+	return &Chain{
+		Block: 1000,
+		Pools: []PoolState{
+			{MinDeposit: 0, LockedBlocks: 20, Staked: Ether(2), Pending: Ether(1), Requested: Ether(3), Ready: Ether(1)},
+			{Token: USDTAddress, MinDeposit: 10, LockedBlocks: 100, Staked: big.NewInt(1_500_000), Pending: big.NewInt(5e17), Requested: new(big.Int), Ready: new(big.Int)},
+			{Token: USDTAddress, LockedBlocks: 100, Staked: new(big.Int), Pending: new(big.Int), Requested: new(big.Int), Ready: new(big.Int)},
+		},
+	}
+}
+
+// Ether 返回 n ETH 对应的 wei
+func Ether(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e18))
+}
+
+func (c *Chain) BlockNumber(context.Context) (uint64, error) {
+	return c.Block, nil
+}
+
+func (c *Chain) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (c *Chain) CallContract(_ context.Context, call ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	if *call.To != StakeAddress {
+		method, err := ethtx.ERC20ABI.MethodById(call.Data[:4])
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case method.Name == "decimals" && *call.To == MetaNodeAddress:
+			return method.Outputs.Pack(uint8(18))
+		case method.Name == "decimals":
+			return method.Outputs.Pack(uint8(6))
+		case method.Name == "symbol" && *call.To == MetaNodeAddress:
+			return method.Outputs.Pack("MetaNode")
+		case method.Name == "symbol":
+			return method.Outputs.Pack("USDT")
+		}
+		return nil, fmt.Errorf("unexpected token call %s", method.Name)
+	}
+
+	c.CallBlocks = append(c.CallBlocks, block)
+	parsed, err := Stake.MetaNodeStakeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := parsed.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "MetaNode":
+		return method.Outputs.Pack(MetaNodeAddress)
+	case "poolLength":
+		return method.Outputs.Pack(big.NewInt(int64(len(c.Pools))))
+	}
+	pool := c.Pools[args[0].(*big.Int).Uint64()]
+	switch method.Name {
+	case "pool":
+		return method.Outputs.Pack(pool.Token, big.NewInt(100), big.NewInt(int64(c.Block)), big.NewInt(0),
+			pool.Staked, big.NewInt(pool.MinDeposit), big.NewInt(pool.LockedBlocks))
+	case "stakingBalance":
+		return method.Outputs.Pack(pool.Staked)
+	case "pendingMetaNode":
+		return method.Outputs.Pack(pool.Pending)
+	case "withdrawAmount":
+		return method.Outputs.Pack(pool.Requested, pool.Ready)
+	}
+	return nil, fmt.Errorf("unexpected call %s", method.Name)
+}
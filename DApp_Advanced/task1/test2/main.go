@@ -2,419 +2,190 @@ package main
 
 import (
 	"context"
-	"crypto/ecdsa"
+	"errors"
+	"flag"
 	"fmt"
+	"io/fs"
 	"log"
 	"math/big"
-	"time"
+	"os"
+	"os/signal"
+	"syscall"
 
 	Counter "counter/counter" // 别名导入，使用首字母大写的包名
+	"ethtx"
 
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/joho/godotenv"
 )
 
 func main() {
-	// 连接到Sepolia测试网络（HTTP端点，用于交易和合约调用）
-	client, err := ethclient.Dial("https://sepolia.infura.io/v3/1ea13488a02d481c8663b068c0d6fa35")
-	if err != nil {
-		log.Fatalf("Failed to connect to the Ethereum client: %v", err)
+	global := flag.NewFlagSet("counter", flag.ExitOnError)
+	configPath := global.String("config", defaultConfigPath, "配置文件路径（YAML）")
+	jsonOutput := global.Bool("json", false, "以JSON输出结果（每个结果一行），便于脚本处理")
+	global.Usage = func() { printUsage(os.Stderr, global) }
+	global.Parse(os.Args[1:])
+
+	if global.NArg() == 0 {
+		global.Usage()
+		os.Exit(2)
+	}
+	cmd, ok := findCommand(global.Arg(0))
+	if !ok {
+		log.Fatalf("未知命令 %q（可用命令: %s）", global.Arg(0), commandNames())
 	}
 
-	// 替换为已部署的合约地址
-	contractAddress := common.HexToAddress("0x42c3e45FF2E9AF12F21f5FEF6F7B874aDB9eBeBc")
-	// 实例化合约
-	instance, err := Counter.NewCounter(contractAddress, client)
+	// 加载 .env 中的环境变量（文件不存在时忽略），再加载配置文件并用环境变量覆盖
+	if err := godotenv.Load(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.Fatalf("Error loading .env file: %v", err)
+	}
+	cfg, err := LoadConfig(*configPath)
 	if err != nil {
-		log.Fatalf("Failed to instantiate a Counter contract: %v", err)
+		log.Fatalf("加载配置失败: %v", err)
 	}
 
-	// 创建事件处理器，避免事件漏处理或重复处理
-	eventHandler := setupEventHandler(instance, client, contractAddress)
-
-	// 开始监听事件
-	eventHandler.StartListening()
-
-	// 调用合约函数
-	callContractFunction(client, instance)
+	// 收到 SIGINT（Ctrl+C）或 SIGTERM 时取消 ctx，优雅地停止监听或等待
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
-	// 保持程序运行以继续监听事件
-	// 打印提示信息，可以通过按下 Ctrl+C 组合键来停止程序运行，进而停止监听事件
-	fmt.Println("按Ctrl+C停止监听...")
-	// 使用 select 语句且不提供任何 case，这会使当前 goroutine 永久阻塞
-	// 因为没有可执行的 case，程序会一直处于等待状态，从而保持监听事件的 goroutine 持续运行
-	// 只有当用户手动中断程序（如按下 Ctrl+C）时，程序才会终止
-	select {}
-
-}
+	// 连接到配置的节点（HTTP端点，用于交易和合约调用）；配置了备用端点时，查询请求在限流、5xx和超时时重试并切换端点，
+	// 每个端点第一次使用前核对链ID
+	clientConfig := ethtx.DefaultClientConfig(cfg.RPCURLs()...)
+	clientConfig.ChainID = new(big.Int).SetUint64(cfg.Network.ChainID)
+	client, err := ethtx.DialClient(ctx, clientConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to the Ethereum client: %v", err)
+	}
+	defer client.Close()
 
-// 合约函数调用
-func callContractFunction(client *ethclient.Client, instance *Counter.Counter) {
-	// 替换为你的私钥
-	privateKey, err := crypto.HexToECDSA("5ed85e0536a6d5eec133290546d2fc6e98e5d80bacafe1aceb27c5e681fd581b")
+	// 确认节点所在的链与配置一致，避免把交易发到错误的网络
+	chainID, err := client.ChainID(ctx)
 	if err != nil {
-		log.Fatalf("Failed to parse private key: %v", err)
+		log.Fatalf("Failed to get chain ID: %v", err)
+	}
+	if chainID.Uint64() != cfg.Network.ChainID {
+		log.Fatalf("节点链ID为 %s，与配置的 network.chain_id=%d 不一致", chainID, cfg.Network.ChainID)
+	}
+
+	app := &cli{cfg: cfg, backend: client, chainID: chainID, json: *jsonOutput, out: os.Stdout}
+	if err := cmd.run(app, ctx, global.Args()[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(2)
+		}
+		log.Fatalf("%s 失败: %v", cmd.name, err)
 	}
+}
 
-	// 获取公钥地址
-	publicKey := privateKey.Public().(*ecdsa.PublicKey)
-	fromAddress := crypto.PubkeyToAddress(*publicKey)
+// setupEventHandler 创建并配置事件处理器，onProcessed 处理确认后的changeCount事件，onRolledBack 处理被链重组移除的已处理事件
+// 返回的事件存储需要在处理器停止后关闭
+func setupEventHandler(client ChainClient, cfg *Config, onProcessed, onRolledBack func(*Counter.CounterChangeCount) error) (*EventHandler, EventStore) {
+	eventsClient, isWebSocket, dialWebSocket := dialEventsClient(client, cfg)
 
-	// 设置交易选项
-	nonce, err := client.PendingNonceAt(context.Background(), fromAddress)
+	// 打开事件存储（追加写日志），配置了 legacy_store 时导入旧版JSON存储中的记录
+	store, err := OpenEventStore(cfg.Events.StoreBackend, cfg.Events.StorePath)
 	if err != nil {
-		log.Fatalf("Failed to get pending nonce: %v", err)
+		log.Fatalf("打开事件存储失败: %v", err)
+	}
+	if cfg.Events.LegacyStore != "" {
+		if imported, err := ImportLegacyStore(store, cfg.Events.LegacyStore); err != nil {
+			log.Printf("导入旧版事件存储失败: %v", err)
+		} else if imported > 0 {
+			log.Printf("已从旧版事件存储导入 %d 条记录", imported)
+		}
 	}
 
-	gasPrice, err := client.SuggestGasPrice(context.Background())
+	// 根据Counter合约的ABI创建事件处理器，只监听changeCount事件
+	handler, err := NewEventHandler(
+		eventsClient,
+		store,
+		Counter.CounterMetaData.ABI,
+		[]common.Address{cfg.ContractAddress()},
+		[]string{"changeCount"},
+		isWebSocket,
+		dialWebSocket,
+	)
 	if err != nil {
-		log.Fatalf("Failed to suggest gas price: %v", err)
+		log.Fatalf("创建事件处理器失败: %v", err)
+	}
+	if err := HandleTyped(handler, "changeCount", onProcessed, onRolledBack); err != nil {
+		log.Fatalf("注册事件处理函数失败: %v", err)
 	}
 
-	// 获取链ID (Sepolia测试网络的链ID是11155111)
-	chainID, err := client.ChainID(context.Background())
+	configureEventHandler(handler, client, cfg)
+	return handler, store
+}
+
+// setupAuctionIndexer 创建拍卖索引器及其事件处理器：先从事件存储恢复已处理的拍卖事件，
+// 再监听工厂合约和已知的拍卖合约。返回的事件存储需要在处理器停止后关闭
+func setupAuctionIndexer(client ChainClient, cfg *Config) (*AuctionIndexer, *EventHandler, EventStore, error) {
+	indexer, err := NewAuctionIndexer(cfg.AuctionFactoryAddress())
 	if err != nil {
-		log.Fatalf("Failed to get chain ID: %v", err)
+		return nil, nil, nil, err
 	}
-
-	// 创建交易签名器
-	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
+	abiJSON, err := auctionEventsABI()
 	if err != nil {
-		log.Fatalf("Failed to create transactor: %v", err)
+		return nil, nil, nil, fmt.Errorf("build auction events ABI: %w", err)
 	}
-	auth.Nonce = big.NewInt(int64(nonce))
-	auth.Value = big.NewInt(0)     // 不发送以太币
-	auth.GasLimit = uint64(300000) // 设置足够的gas限制
-	auth.GasPrice = gasPrice
 
-	// 调用increment方法
-	fmt.Println("Calling increment method...")
-	tx, err := instance.Increment(auth)
+	// 拍卖事件单独存储，检查点与Counter事件互不影响
+	store, err := OpenEventStore(cfg.Events.StoreBackend, cfg.Events.AuctionStore)
 	if err != nil {
-		log.Fatalf("Failed to call increment method: %v", err)
+		return nil, nil, nil, fmt.Errorf("open auction event store: %w", err)
 	}
-
-	fmt.Printf("Transaction hash: %s\n", tx.Hash().Hex())
-
-	_, err = waitForReceipt(client, tx.Hash())
+	restored, err := indexer.Restore(store)
 	if err != nil {
-		log.Fatal(err)
+		store.Close()
+		return nil, nil, nil, fmt.Errorf("restore auction index: %w", err)
+	}
+	if restored > 0 {
+		log.Printf("已从事件存储恢复 %d 个拍卖事件", restored)
 	}
 
-	// 读取计数器值
-	count, err := instance.GetCount(&bind.CallOpts{Context: context.Background()})
+	eventsClient, isWebSocket, dialWebSocket := dialEventsClient(client, cfg)
+	handler, err := NewEventHandler(eventsClient, store, abiJSON, indexer.Addresses(), auctionEventNames, isWebSocket, dialWebSocket)
 	if err != nil {
-		log.Fatalf("Failed to call getCount method: %v", err)
+		store.Close()
+		return nil, nil, nil, fmt.Errorf("create auction event handler: %w", err)
+	}
+	if err := indexer.Register(handler); err != nil {
+		store.Close()
+		return nil, nil, nil, fmt.Errorf("register auction handlers: %w", err)
 	}
 
-	fmt.Printf("Current count: %d\n", count)
-}
-
-// 监听Counter合约的changeCount事件并打印最新触发的事件结果（使用轮询方式，作为备用方案）
-func listenChangeCountEvent(instance *Counter.Counter) {
-	fmt.Println("开始使用轮询方式监听changeCount事件...")
-
-	// 在goroutine中以轮询方式查询最新事件
-	go func() {
-		var lastBlock uint64 = 0
-		for {
-			// 创建新的过滤选项，每次轮询都从最后处理的区块开始查询
-			filterOpts := &bind.FilterOpts{
-				Start:   lastBlock, // 从最后处理的区块开始
-				End:     nil,       // 不设置结束区块，查询到最新区块
-				Context: context.Background(),
-			}
-
-			// 查询事件
-			iterator, err := instance.FilterChangeCount(filterOpts)
-			if err != nil {
-				log.Printf("过滤事件错误: %v\n", err)
-				// 等待一段时间后重试
-				time.Sleep(3 * time.Second)
-				continue
-			}
-
-			// 重置最大区块号跟踪变量，用于本次轮询
-			var maxBlockNumber uint64 = lastBlock
-
-			// 遍历查询到的事件
-			for iterator.Next() {
-				event := iterator.Event
-				// 只处理新区块中的事件
-				if event.Raw.BlockNumber > lastBlock {
-					fmt.Printf("监听到最新changeCount事件:\n")
-					fmt.Printf("  Action: %s\n", event.Action)
-					fmt.Printf("  By: %s\n", event.By.String())
-					fmt.Printf("  NewCount: %s\n", event.NewCount.String())
-					fmt.Printf("  TxHash: %s\n\n", event.Raw.TxHash.Hex())
-				}
-
-				// 跟踪最大区块号
-				if event.Raw.BlockNumber > maxBlockNumber {
-					maxBlockNumber = event.Raw.BlockNumber
-				}
-			}
-
-			// 更新lastBlock为本次轮询中找到的最大区块号
-			lastBlock = maxBlockNumber
-
-			// 关闭迭代器
-			iterator.Close()
-
-			// 等待一段时间后再次查询
-			time.Sleep(2 * time.Second)
-		}
-	}()
-}
-
-// 监听Counter合约的changeCount事件并打印最新触发的事件结果（使用WebSocket实时订阅）
-func listenChangeCountEventWithWS(instance *Counter.Counter, client *ethclient.Client) {
-	fmt.Println("开始使用WebSocket实时订阅changeCount事件...")
-
-	// 创建上下文，用于取消订阅
-	ctx, cancel := context.WithCancel(context.Background())
-
-	// 在goroutine中监听事件
-	go func() {
-		// 创建事件通道
-		eventChan := make(chan *Counter.CounterChangeCount)
-
-		// 创建订阅选项
-		watchOpts := &bind.WatchOpts{
-			Context: ctx,
-		}
-
-		// 订阅事件
-		sub, err := instance.WatchChangeCount(watchOpts, eventChan)
-		if err != nil {
-			log.Printf("Failed to watch changeCount event: %v\n", err)
-			cancel()
-			return
-		}
-
-		// 监听完成时取消订阅和关闭通道
-		defer func() {
-			sub.Unsubscribe()
-			close(eventChan)
-			cancel()
-		}()
-
-		// 处理接收到的事件
-		for {
-			select {
-			case event := <-eventChan:
-				// 打印最新事件
-				fmt.Printf("监听到最新changeCount事件:\n")
-				fmt.Printf("  Action: %s\n", event.Action)
-				fmt.Printf("  By: %s\n", event.By.String())
-				fmt.Printf("  NewCount: %s\n", event.NewCount.String())
-				fmt.Printf("  TxHash: %s\n\n", event.Raw.TxHash.Hex())
-			case err := <-sub.Err():
-				log.Printf("订阅错误: %v\n", err)
-				// 如果发生错误，可以在这里重新订阅或退出
-				return
-			}
-		}
-	}()
+	configureEventHandler(handler, client, cfg)
+	return indexer, handler, store, nil
 }
 
-// setupEventHandler 创建并配置事件处理器
-func setupEventHandler(instance *Counter.Counter, client *ethclient.Client, contractAddress common.Address) *EventHandler {
-	// 尝试连接WebSocket客户端
-	eventsClient, wsErr := ethclient.Dial("wss://sepolia.infura.io/ws/v3/1ea13488a02d481c8663b068c0d6fa35")
+// dialEventsClient 返回监听事件使用的客户端：配置了WebSocket端点且能连接时使用WebSocket，否则使用HTTP客户端轮询
+func dialEventsClient(client ChainClient, cfg *Config) (ChainClient, bool, WebSocketDialer) {
+	if cfg.Network.WSURL == "" {
+		log.Println("未配置WebSocket端点，使用HTTP客户端进行轮询")
+		return client, false, nil
+	}
 
-	var eventInstance *Counter.Counter
-	var isWebSocket bool
+	// 重连时根据配置的WebSocket端点重新建立连接
+	dialWebSocket := func(ctx context.Context) (ChainClient, error) {
+		return ethclient.DialContext(ctx, cfg.Network.WSURL)
+	}
 
+	wsClient, wsErr := ethclient.Dial(cfg.Network.WSURL)
 	if wsErr != nil {
 		// WebSocket连接失败，使用HTTP客户端
 		log.Printf("WebSocket客户端连接失败: %v，使用HTTP客户端进行轮询", wsErr)
-		eventInstance = instance
-		isWebSocket = false
-	} else {
-		// 使用WebSocket客户端创建专门用于事件监听的合约实例
-		eventsInstance, err := Counter.NewCounter(contractAddress, eventsClient)
-		if err != nil {
-			log.Printf("使用WebSocket创建合约实例失败: %v，回退到HTTP客户端", err)
-			eventInstance = instance
-			isWebSocket = false
-		} else {
-			log.Println("使用WebSocket客户端进行事件监听")
-			eventInstance = eventsInstance
-			isWebSocket = true
-		}
-	}
-
-	// 定义事件处理函数
-	onEventProcessed := func(event *Counter.CounterChangeCount) error {
-		// 这里是事件处理逻辑
-		fmt.Printf("监听到最新changeCount事件:\n")
-		fmt.Printf("  Action: %s\n", event.Action)
-		fmt.Printf("  By: %s\n", event.By.String())
-		fmt.Printf("  NewCount: %s\n", event.NewCount.String())
-		fmt.Printf("  TxHash: %s\n\n", event.Raw.TxHash.Hex())
-		return nil
+		return client, false, dialWebSocket
 	}
-
-	// 定义重连函数
-	onReconnect := func() error {
-		// 当WebSocket连接断开时，实现真实的重连逻辑
-		log.Println("尝试重新连接WebSocket...")
-
-		// 重连次数限制
-		const maxRetries = 5
-		// 指数退避时间，用于控制每次重连失败后等待的时间。
-		// 初始退避时间设置为 1 秒，后续每次重连失败时，该时间会成倍增加，
-		// 以避免短时间内频繁尝试重连给服务器造成过大压力。
-		retryInterval := time.Second
-
-		for attempt := 1; attempt <= maxRetries; attempt++ {
-			log.Printf("重连尝试 #%d/%d...", attempt, maxRetries)
-
-			// 尝试创建新的WebSocket客户端连接
-			// 在go-ethereum中，WebSocket连接通过Dial方法实现
-			wsc, wsErr := ethclient.Dial("ws://localhost:8545")
-			if wsErr == nil {
-				log.Println("WebSocket重连成功！")
-				// 更新客户端连接
-				client = wsc
-				return nil
-			}
-
-			log.Printf("重连失败: %v，%d秒后重试...", wsErr, retryInterval/time.Second)
-			time.Sleep(retryInterval)
-			// 指数退避
-			retryInterval *= 2
-		}
-
-		log.Printf("已达到最大重连次数(%d)，重连失败", maxRetries)
-		return fmt.Errorf("failed to reconnect after %d attempts", maxRetries)
-	}
-
-	// 创建并返回事件处理器
-	return NewEventHandler(eventInstance, client, isWebSocket, onEventProcessed, onReconnect)
+	log.Println("使用WebSocket客户端进行事件监听")
+	return wsClient, true, dialWebSocket
 }
 
-func waitForReceipt(client *ethclient.Client, txHash common.Hash) (*types.Receipt, error) {
-	for {
-		receipt, err := client.TransactionReceipt(context.Background(), txHash)
-		if err == nil {
-			return receipt, nil
-		}
-		// 等待一段时间后再次查询
-		time.Sleep(1 * time.Second)
-	}
+// configureEventHandler 按配置设置事件处理器的回退客户端、确认数和起始区块等参数
+func configureEventHandler(handler *EventHandler, client ChainClient, cfg *Config) {
+	// WebSocket持续不可用时使用HTTP客户端轮询
+	handler.SetFallbackClient(client)
+	// 等待配置的区块确认数后再处理事件
+	handler.SetConfirmations(cfg.Events.Confirmations)
+	handler.SetFinalityDepth(cfg.Events.FinalityDepth)
+	handler.SetStartBlock(cfg.Events.StartBlock)
+	handler.SetMaxHealthyLag(cfg.Events.MaxHealthyLag)
 }
-
-// 以下函数已被新的事件处理器替代，保留仅供参考
-/*
-// 监听Counter合约的changeCount事件并打印最新触发的事件结果（使用轮询方式，作为备用方案）
-func listenChangeCountEvent(instance *Counter.Counter) {
-	fmt.Println("开始使用轮询方式监听changeCount事件...")
-
-	// 在goroutine中以轮询方式查询最新事件
-	go func() {
-		var lastBlock uint64 = 0
-		for {
-			// 创建新的过滤选项，每次轮询都从最后处理的区块开始查询
-			filterOpts := &bind.FilterOpts{
-				Start:   lastBlock, // 从最后处理的区块开始
-				End:     nil,       // 不设置结束区块，查询到最新区块
-				Context: context.Background(),
-			}
-
-			// 查询事件
-			iterator, err := instance.FilterChangeCount(filterOpts)
-			if err != nil {
-				log.Printf("过滤事件错误: %v\n", err)
-				// 等待一段时间后重试
-				time.Sleep(3 * time.Second)
-				continue
-			}
-
-			// 重置最大区块号跟踪变量，用于本次轮询
-			var maxBlockNumber uint64 = lastBlock
-
-			// 遍历查询到的事件
-			for iterator.Next() {
-				event := iterator.Event
-				// 只处理新区块中的事件
-				if event.Raw.BlockNumber > lastBlock {
-					fmt.Printf("监听到最新changeCount事件:\n")
-					fmt.Printf("  Action: %s\n", event.Action)
-					fmt.Printf("  By: %s\n", event.By.String())
-					fmt.Printf("  NewCount: %s\n", event.NewCount.String())
-					fmt.Printf("  TxHash: %s\n\n", event.Raw.TxHash.Hex())
-				}
-
-				// 跟踪最大区块号
-				if event.Raw.BlockNumber > maxBlockNumber {
-					maxBlockNumber = event.Raw.BlockNumber
-				}
-			}
-
-			// 更新lastBlock为本次轮询中找到的最大区块号
-			lastBlock = maxBlockNumber
-
-			// 关闭迭代器
-			iterator.Close()
-
-			// 等待一段时间后再次查询
-			time.Sleep(2 * time.Second)
-		}
-	}()
-}
-
-// 监听Counter合约的changeCount事件并打印最新触发的事件结果（使用WebSocket实时订阅）
-func listenChangeCountEventWithWS(instance *Counter.Counter, client *ethclient.Client) {
-	fmt.Println("开始使用WebSocket实时订阅changeCount事件...")
-
-	// 创建上下文，用于取消订阅
-	ctx, cancel := context.WithCancel(context.Background())
-
-	// 在goroutine中监听事件
-	go func() {
-		// 创建事件通道
-		eventChan := make(chan *Counter.CounterChangeCount)
-
-		// 创建订阅选项
-		watchOpts := &bind.WatchOpts{
-			Context: ctx,
-		}
-
-		// 订阅事件
-		sub, err := instance.WatchChangeCount(watchOpts, eventChan)
-		if err != nil {
-			log.Printf("Failed to watch changeCount event: %v\n", err)
-			cancel()
-			return
-		}
-
-		// 监听完成时取消订阅和关闭通道
-		defer func() {
-			sub.Unsubscribe()
-			close(eventChan)
-			cancel()
-		}()
-
-		// 处理接收到的事件
-		for {
-			select {
-			case event := <-eventChan:
-				// 打印最新事件
-				fmt.Printf("监听到最新changeCount事件:\n")
-				fmt.Printf("  Action: %s\n", event.Action)
-				fmt.Printf("  By: %s\n", event.By.String())
-				fmt.Printf("  NewCount: %s\n", event.NewCount.String())
-				fmt.Printf("  TxHash: %s\n\n", event.Raw.TxHash.Hex())
-			case err := <-sub.Err():
-				log.Printf("订阅错误: %v\n", err)
-				// 如果发生错误，可以在这里重新订阅或退出
-				return
-			}
-		}
-	}()
-}*/
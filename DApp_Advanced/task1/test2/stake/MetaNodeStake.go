@@ -0,0 +1,4912 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package Stake
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// MetaNodeStakeMetaData contains all meta data concerning the MetaNodeStake contract.
+var MetaNodeStakeMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"AccessControlBadConfirmation\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"neededRole\",\"type\":\"bytes32\"}],\"name\":\"AccessControlUnauthorizedAccount\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"target\",\"type\":\"address\"}],\"name\":\"AddressEmptyCode\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"ERC1967InvalidImplementation\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ERC1967NonPayable\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"EnforcedPause\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ExpectedPause\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"FailedInnerCall\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"}],\"name\":\"SafeERC20FailedOperation\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"UUPSUnauthorizedCallContext\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"slot\",\"type\":\"bytes32\"}],\"name\":\"UUPSUnsupportedProxiableUUID\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"stTokenAddress\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"poolWeight\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"lastRewardBlock\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"minDepositAmount\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"unstakeLockedBlocks\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"AddPool\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"poolId\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"MetaNodeReward\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"Claim\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"poolId\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"Deposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"PauseClaim\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"PauseWithdraw\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\",\"indexed\":false}],\"name\":\"Paused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"poolId\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"RequestUnstake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\",\"indexed\":true},{\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\",\"indexed\":true},{\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\",\"indexed\":true}],\"name\":\"RoleAdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\",\"indexed\":true}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\",\"indexed\":true}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"endBlock\",\"type\":\"uint256\",\"indexed\":true}],\"name\":\"SetEndBlock\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"contractIERC20\",\"name\":\"MetaNode\",\"type\":\"address\",\"indexed\":true}],\"name\":\"SetMetaNode\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"MetaNodePerBlock\",\"type\":\"uint256\",\"indexed\":true}],\"name\":\"SetMetaNodePerBlock\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"poolId\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"poolWeight\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"totalPoolWeight\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"SetPoolWeight\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"startBlock\",\"type\":\"uint256\",\"indexed\":true}],\"name\":\"SetStartBlock\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"UnpauseClaim\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"UnpauseWithdraw\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\",\"indexed\":false}],\"name\":\"Unpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"poolId\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"lastRewardBlock\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"totalMetaNode\",\"type\":\"uint256\",\"indexed\":false}],\"name\":\"UpdatePool\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"poolId\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"minDepositAmount\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"unstakeLockedBlocks\",\"type\":\"uint256\",\"indexed\":true}],\"name\":\"UpdatePoolInfo\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\",\"indexed\":true}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"user\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"poolId\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"blockNumber\",\"type\":\"uint256\",\"indexed\":true}],\"name\":\"Withdraw\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DEFAULT_ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ETH_PID\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MetaNode\",\"outputs\":[{\"internalType\":\"contractIERC20\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MetaNodePerBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"UPGRADE_INTERFACE_VERSION\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"UPGRADE_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_stTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_poolWeight\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_minDepositAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_unstakeLockedBlocks\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"_withUpdate\",\"type\":\"bool\"}],\"name\":\"addPool\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"name\":\"claim\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"claimPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"deposit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"depositETH\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"endBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_from\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_to\",\"type\":\"uint256\"}],\"name\":\"getMultiplier\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"multiplier\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleAdmin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"grantRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"hasRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIERC20\",\"name\":\"_MetaNode\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_startBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_endBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_MetaNodePerBlock\",\"type\":\"uint256\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"massUpdatePools\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pauseClaim\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pauseWithdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"paused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_user\",\"type\":\"address\"}],\"name\":\"pendingMetaNode\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_user\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_blockNumber\",\"type\":\"uint256\"}],\"name\":\"pendingMetaNodeByBlockNumber\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"pool\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"stTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"poolWeight\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lastRewardBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"accMetaNodePerST\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"stTokenAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minDepositAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unstakeLockedBlocks\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"poolLength\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"proxiableUUID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"callerConfirmation\",\"type\":\"address\"}],\"name\":\"renounceRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"revokeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_endBlock\",\"type\":\"uint256\"}],\"name\":\"setEndBlock\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIERC20\",\"name\":\"_MetaNode\",\"type\":\"address\"}],\"name\":\"setMetaNode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_MetaNodePerBlock\",\"type\":\"uint256\"}],\"name\":\"setMetaNodePerBlock\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_poolWeight\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"_withUpdate\",\"type\":\"bool\"}],\"name\":\"setPoolWeight\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_startBlock\",\"type\":\"uint256\"}],\"name\":\"setStartBlock\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_user\",\"type\":\"address\"}],\"name\":\"stakingBalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"startBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalPoolWeight\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpauseClaim\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpauseWithdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"unstake\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"name\":\"updatePool\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_minDepositAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_unstakeLockedBlocks\",\"type\":\"uint256\"}],\"name\":\"updatePool\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"user\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"stAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"finishedMetaNode\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"pendingMetaNode\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"name\":\"withdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_user\",\"type\":\"address\"}],\"name\":\"withdrawAmount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"requestAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"pendingWithdrawAmount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdrawPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// MetaNodeStakeABI is the input ABI used to generate the binding from.
+// Deprecated: Use MetaNodeStakeMetaData.ABI instead.
+var MetaNodeStakeABI = MetaNodeStakeMetaData.ABI
+
+// MetaNodeStake is an auto generated Go binding around an Ethereum contract.
+type MetaNodeStake struct {
+	MetaNodeStakeCaller     // Read-only binding to the contract
+	MetaNodeStakeTransactor // Write-only binding to the contract
+	MetaNodeStakeFilterer   // Log filterer for contract events
+}
+
+// MetaNodeStakeCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MetaNodeStakeCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MetaNodeStakeTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MetaNodeStakeTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MetaNodeStakeFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MetaNodeStakeFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MetaNodeStakeSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MetaNodeStakeSession struct {
+	Contract     *MetaNodeStake    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// MetaNodeStakeCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MetaNodeStakeCallerSession struct {
+	Contract *MetaNodeStakeCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// MetaNodeStakeTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MetaNodeStakeTransactorSession struct {
+	Contract     *MetaNodeStakeTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// MetaNodeStakeRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MetaNodeStakeRaw struct {
+	Contract *MetaNodeStake // Generic contract binding to access the raw methods on
+}
+
+// MetaNodeStakeCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MetaNodeStakeCallerRaw struct {
+	Contract *MetaNodeStakeCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MetaNodeStakeTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MetaNodeStakeTransactorRaw struct {
+	Contract *MetaNodeStakeTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMetaNodeStake creates a new instance of MetaNodeStake, bound to a specific deployed contract.
+func NewMetaNodeStake(address common.Address, backend bind.ContractBackend) (*MetaNodeStake, error) {
+	contract, err := bindMetaNodeStake(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStake{MetaNodeStakeCaller: MetaNodeStakeCaller{contract: contract}, MetaNodeStakeTransactor: MetaNodeStakeTransactor{contract: contract}, MetaNodeStakeFilterer: MetaNodeStakeFilterer{contract: contract}}, nil
+}
+
+// NewMetaNodeStakeCaller creates a new read-only instance of MetaNodeStake, bound to a specific deployed contract.
+func NewMetaNodeStakeCaller(address common.Address, caller bind.ContractCaller) (*MetaNodeStakeCaller, error) {
+	contract, err := bindMetaNodeStake(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeCaller{contract: contract}, nil
+}
+
+// NewMetaNodeStakeTransactor creates a new write-only instance of MetaNodeStake, bound to a specific deployed contract.
+func NewMetaNodeStakeTransactor(address common.Address, transactor bind.ContractTransactor) (*MetaNodeStakeTransactor, error) {
+	contract, err := bindMetaNodeStake(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeTransactor{contract: contract}, nil
+}
+
+// NewMetaNodeStakeFilterer creates a new log filterer instance of MetaNodeStake, bound to a specific deployed contract.
+func NewMetaNodeStakeFilterer(address common.Address, filterer bind.ContractFilterer) (*MetaNodeStakeFilterer, error) {
+	contract, err := bindMetaNodeStake(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeFilterer{contract: contract}, nil
+}
+
+// bindMetaNodeStake binds a generic wrapper to an already deployed contract.
+func bindMetaNodeStake(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MetaNodeStakeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MetaNodeStake *MetaNodeStakeRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MetaNodeStake.Contract.MetaNodeStakeCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MetaNodeStake *MetaNodeStakeRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.MetaNodeStakeTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MetaNodeStake *MetaNodeStakeRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.MetaNodeStakeTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MetaNodeStake *MetaNodeStakeCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MetaNodeStake.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MetaNodeStake *MetaNodeStakeTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MetaNodeStake *MetaNodeStakeTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.contract.Transact(opts, method, params...)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_MetaNodeStake *MetaNodeStakeCaller) ADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_MetaNodeStake *MetaNodeStakeSession) ADMINROLE() ([32]byte, error) {
+	return _MetaNodeStake.Contract.ADMINROLE(&_MetaNodeStake.CallOpts)
+}
+
+// ADMINROLE is a free data retrieval call binding the contract method 0x75b238fc.
+//
+// Solidity: function ADMIN_ROLE() view returns(bytes32)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) ADMINROLE() ([32]byte, error) {
+	return _MetaNodeStake.Contract.ADMINROLE(&_MetaNodeStake.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_MetaNodeStake *MetaNodeStakeCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_MetaNodeStake *MetaNodeStakeSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _MetaNodeStake.Contract.DEFAULTADMINROLE(&_MetaNodeStake.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _MetaNodeStake.Contract.DEFAULTADMINROLE(&_MetaNodeStake.CallOpts)
+}
+
+// ETHPID is a free data retrieval call binding the contract method 0xbfc3ebba.
+//
+// Solidity: function ETH_PID() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCaller) ETHPID(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "ETH_PID")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ETHPID is a free data retrieval call binding the contract method 0xbfc3ebba.
+//
+// Solidity: function ETH_PID() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeSession) ETHPID() (*big.Int, error) {
+	return _MetaNodeStake.Contract.ETHPID(&_MetaNodeStake.CallOpts)
+}
+
+// ETHPID is a free data retrieval call binding the contract method 0xbfc3ebba.
+//
+// Solidity: function ETH_PID() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) ETHPID() (*big.Int, error) {
+	return _MetaNodeStake.Contract.ETHPID(&_MetaNodeStake.CallOpts)
+}
+
+// MetaNode is a free data retrieval call binding the contract method 0xd22f1d80.
+//
+// Solidity: function MetaNode() view returns(address)
+func (_MetaNodeStake *MetaNodeStakeCaller) MetaNode(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "MetaNode")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// MetaNode is a free data retrieval call binding the contract method 0xd22f1d80.
+//
+// Solidity: function MetaNode() view returns(address)
+func (_MetaNodeStake *MetaNodeStakeSession) MetaNode() (common.Address, error) {
+	return _MetaNodeStake.Contract.MetaNode(&_MetaNodeStake.CallOpts)
+}
+
+// MetaNode is a free data retrieval call binding the contract method 0xd22f1d80.
+//
+// Solidity: function MetaNode() view returns(address)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) MetaNode() (common.Address, error) {
+	return _MetaNodeStake.Contract.MetaNode(&_MetaNodeStake.CallOpts)
+}
+
+// MetaNodePerBlock is a free data retrieval call binding the contract method 0x9ea96e66.
+//
+// Solidity: function MetaNodePerBlock() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCaller) MetaNodePerBlock(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "MetaNodePerBlock")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MetaNodePerBlock is a free data retrieval call binding the contract method 0x9ea96e66.
+//
+// Solidity: function MetaNodePerBlock() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeSession) MetaNodePerBlock() (*big.Int, error) {
+	return _MetaNodeStake.Contract.MetaNodePerBlock(&_MetaNodeStake.CallOpts)
+}
+
+// MetaNodePerBlock is a free data retrieval call binding the contract method 0x9ea96e66.
+//
+// Solidity: function MetaNodePerBlock() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) MetaNodePerBlock() (*big.Int, error) {
+	return _MetaNodeStake.Contract.MetaNodePerBlock(&_MetaNodeStake.CallOpts)
+}
+
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_MetaNodeStake *MetaNodeStakeCaller) UPGRADEINTERFACEVERSION(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "UPGRADE_INTERFACE_VERSION")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_MetaNodeStake *MetaNodeStakeSession) UPGRADEINTERFACEVERSION() (string, error) {
+	return _MetaNodeStake.Contract.UPGRADEINTERFACEVERSION(&_MetaNodeStake.CallOpts)
+}
+
+// UPGRADEINTERFACEVERSION is a free data retrieval call binding the contract method 0xad3cb1cc.
+//
+// Solidity: function UPGRADE_INTERFACE_VERSION() view returns(string)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) UPGRADEINTERFACEVERSION() (string, error) {
+	return _MetaNodeStake.Contract.UPGRADEINTERFACEVERSION(&_MetaNodeStake.CallOpts)
+}
+
+// UPGRADEROLE is a free data retrieval call binding the contract method 0xb908afa8.
+//
+// Solidity: function UPGRADE_ROLE() view returns(bytes32)
+func (_MetaNodeStake *MetaNodeStakeCaller) UPGRADEROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "UPGRADE_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// UPGRADEROLE is a free data retrieval call binding the contract method 0xb908afa8.
+//
+// Solidity: function UPGRADE_ROLE() view returns(bytes32)
+func (_MetaNodeStake *MetaNodeStakeSession) UPGRADEROLE() ([32]byte, error) {
+	return _MetaNodeStake.Contract.UPGRADEROLE(&_MetaNodeStake.CallOpts)
+}
+
+// UPGRADEROLE is a free data retrieval call binding the contract method 0xb908afa8.
+//
+// Solidity: function UPGRADE_ROLE() view returns(bytes32)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) UPGRADEROLE() ([32]byte, error) {
+	return _MetaNodeStake.Contract.UPGRADEROLE(&_MetaNodeStake.CallOpts)
+}
+
+// ClaimPaused is a free data retrieval call binding the contract method 0xab5e124a.
+//
+// Solidity: function claimPaused() view returns(bool)
+func (_MetaNodeStake *MetaNodeStakeCaller) ClaimPaused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "claimPaused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// ClaimPaused is a free data retrieval call binding the contract method 0xab5e124a.
+//
+// Solidity: function claimPaused() view returns(bool)
+func (_MetaNodeStake *MetaNodeStakeSession) ClaimPaused() (bool, error) {
+	return _MetaNodeStake.Contract.ClaimPaused(&_MetaNodeStake.CallOpts)
+}
+
+// ClaimPaused is a free data retrieval call binding the contract method 0xab5e124a.
+//
+// Solidity: function claimPaused() view returns(bool)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) ClaimPaused() (bool, error) {
+	return _MetaNodeStake.Contract.ClaimPaused(&_MetaNodeStake.CallOpts)
+}
+
+// EndBlock is a free data retrieval call binding the contract method 0x083c6323.
+//
+// Solidity: function endBlock() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCaller) EndBlock(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "endBlock")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// EndBlock is a free data retrieval call binding the contract method 0x083c6323.
+//
+// Solidity: function endBlock() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeSession) EndBlock() (*big.Int, error) {
+	return _MetaNodeStake.Contract.EndBlock(&_MetaNodeStake.CallOpts)
+}
+
+// EndBlock is a free data retrieval call binding the contract method 0x083c6323.
+//
+// Solidity: function endBlock() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) EndBlock() (*big.Int, error) {
+	return _MetaNodeStake.Contract.EndBlock(&_MetaNodeStake.CallOpts)
+}
+
+// GetMultiplier is a free data retrieval call binding the contract method 0x8dbb1e3a.
+//
+// Solidity: function getMultiplier(uint256 _from, uint256 _to) view returns(uint256 multiplier)
+func (_MetaNodeStake *MetaNodeStakeCaller) GetMultiplier(opts *bind.CallOpts, _from *big.Int, _to *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "getMultiplier", _from, _to)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMultiplier is a free data retrieval call binding the contract method 0x8dbb1e3a.
+//
+// Solidity: function getMultiplier(uint256 _from, uint256 _to) view returns(uint256 multiplier)
+func (_MetaNodeStake *MetaNodeStakeSession) GetMultiplier(_from *big.Int, _to *big.Int) (*big.Int, error) {
+	return _MetaNodeStake.Contract.GetMultiplier(&_MetaNodeStake.CallOpts, _from, _to)
+}
+
+// GetMultiplier is a free data retrieval call binding the contract method 0x8dbb1e3a.
+//
+// Solidity: function getMultiplier(uint256 _from, uint256 _to) view returns(uint256 multiplier)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) GetMultiplier(_from *big.Int, _to *big.Int) (*big.Int, error) {
+	return _MetaNodeStake.Contract.GetMultiplier(&_MetaNodeStake.CallOpts, _from, _to)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_MetaNodeStake *MetaNodeStakeCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_MetaNodeStake *MetaNodeStakeSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _MetaNodeStake.Contract.GetRoleAdmin(&_MetaNodeStake.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _MetaNodeStake.Contract.GetRoleAdmin(&_MetaNodeStake.CallOpts, role)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_MetaNodeStake *MetaNodeStakeCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_MetaNodeStake *MetaNodeStakeSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _MetaNodeStake.Contract.HasRole(&_MetaNodeStake.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _MetaNodeStake.Contract.HasRole(&_MetaNodeStake.CallOpts, role, account)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_MetaNodeStake *MetaNodeStakeCaller) Paused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "paused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_MetaNodeStake *MetaNodeStakeSession) Paused() (bool, error) {
+	return _MetaNodeStake.Contract.Paused(&_MetaNodeStake.CallOpts)
+}
+
+// Paused is a free data retrieval call binding the contract method 0x5c975abb.
+//
+// Solidity: function paused() view returns(bool)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) Paused() (bool, error) {
+	return _MetaNodeStake.Contract.Paused(&_MetaNodeStake.CallOpts)
+}
+
+// PendingMetaNode is a free data retrieval call binding the contract method 0xea5c219d.
+//
+// Solidity: function pendingMetaNode(uint256 _pid, address _user) view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCaller) PendingMetaNode(opts *bind.CallOpts, _pid *big.Int, _user common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "pendingMetaNode", _pid, _user)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PendingMetaNode is a free data retrieval call binding the contract method 0xea5c219d.
+//
+// Solidity: function pendingMetaNode(uint256 _pid, address _user) view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeSession) PendingMetaNode(_pid *big.Int, _user common.Address) (*big.Int, error) {
+	return _MetaNodeStake.Contract.PendingMetaNode(&_MetaNodeStake.CallOpts, _pid, _user)
+}
+
+// PendingMetaNode is a free data retrieval call binding the contract method 0xea5c219d.
+//
+// Solidity: function pendingMetaNode(uint256 _pid, address _user) view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) PendingMetaNode(_pid *big.Int, _user common.Address) (*big.Int, error) {
+	return _MetaNodeStake.Contract.PendingMetaNode(&_MetaNodeStake.CallOpts, _pid, _user)
+}
+
+// PendingMetaNodeByBlockNumber is a free data retrieval call binding the contract method 0x6325d5bc.
+//
+// Solidity: function pendingMetaNodeByBlockNumber(uint256 _pid, address _user, uint256 _blockNumber) view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCaller) PendingMetaNodeByBlockNumber(opts *bind.CallOpts, _pid *big.Int, _user common.Address, _blockNumber *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "pendingMetaNodeByBlockNumber", _pid, _user, _blockNumber)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PendingMetaNodeByBlockNumber is a free data retrieval call binding the contract method 0x6325d5bc.
+//
+// Solidity: function pendingMetaNodeByBlockNumber(uint256 _pid, address _user, uint256 _blockNumber) view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeSession) PendingMetaNodeByBlockNumber(_pid *big.Int, _user common.Address, _blockNumber *big.Int) (*big.Int, error) {
+	return _MetaNodeStake.Contract.PendingMetaNodeByBlockNumber(&_MetaNodeStake.CallOpts, _pid, _user, _blockNumber)
+}
+
+// PendingMetaNodeByBlockNumber is a free data retrieval call binding the contract method 0x6325d5bc.
+//
+// Solidity: function pendingMetaNodeByBlockNumber(uint256 _pid, address _user, uint256 _blockNumber) view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) PendingMetaNodeByBlockNumber(_pid *big.Int, _user common.Address, _blockNumber *big.Int) (*big.Int, error) {
+	return _MetaNodeStake.Contract.PendingMetaNodeByBlockNumber(&_MetaNodeStake.CallOpts, _pid, _user, _blockNumber)
+}
+
+// Pool is a free data retrieval call binding the contract method 0xfe313112.
+//
+// Solidity: function pool(uint256 ) view returns(address stTokenAddress, uint256 poolWeight, uint256 lastRewardBlock, uint256 accMetaNodePerST, uint256 stTokenAmount, uint256 minDepositAmount, uint256 unstakeLockedBlocks)
+func (_MetaNodeStake *MetaNodeStakeCaller) Pool(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	StTokenAddress      common.Address
+	PoolWeight          *big.Int
+	LastRewardBlock     *big.Int
+	AccMetaNodePerST    *big.Int
+	StTokenAmount       *big.Int
+	MinDepositAmount    *big.Int
+	UnstakeLockedBlocks *big.Int
+}, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "pool", arg0)
+
+	outstruct := new(struct {
+		StTokenAddress      common.Address
+		PoolWeight          *big.Int
+		LastRewardBlock     *big.Int
+		AccMetaNodePerST    *big.Int
+		StTokenAmount       *big.Int
+		MinDepositAmount    *big.Int
+		UnstakeLockedBlocks *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.StTokenAddress = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.PoolWeight = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.LastRewardBlock = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.AccMetaNodePerST = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.StTokenAmount = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.MinDepositAmount = *abi.ConvertType(out[5], new(*big.Int)).(**big.Int)
+	outstruct.UnstakeLockedBlocks = *abi.ConvertType(out[6], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// Pool is a free data retrieval call binding the contract method 0xfe313112.
+//
+// Solidity: function pool(uint256 ) view returns(address stTokenAddress, uint256 poolWeight, uint256 lastRewardBlock, uint256 accMetaNodePerST, uint256 stTokenAmount, uint256 minDepositAmount, uint256 unstakeLockedBlocks)
+func (_MetaNodeStake *MetaNodeStakeSession) Pool(arg0 *big.Int) (struct {
+	StTokenAddress      common.Address
+	PoolWeight          *big.Int
+	LastRewardBlock     *big.Int
+	AccMetaNodePerST    *big.Int
+	StTokenAmount       *big.Int
+	MinDepositAmount    *big.Int
+	UnstakeLockedBlocks *big.Int
+}, error) {
+	return _MetaNodeStake.Contract.Pool(&_MetaNodeStake.CallOpts, arg0)
+}
+
+// Pool is a free data retrieval call binding the contract method 0xfe313112.
+//
+// Solidity: function pool(uint256 ) view returns(address stTokenAddress, uint256 poolWeight, uint256 lastRewardBlock, uint256 accMetaNodePerST, uint256 stTokenAmount, uint256 minDepositAmount, uint256 unstakeLockedBlocks)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) Pool(arg0 *big.Int) (struct {
+	StTokenAddress      common.Address
+	PoolWeight          *big.Int
+	LastRewardBlock     *big.Int
+	AccMetaNodePerST    *big.Int
+	StTokenAmount       *big.Int
+	MinDepositAmount    *big.Int
+	UnstakeLockedBlocks *big.Int
+}, error) {
+	return _MetaNodeStake.Contract.Pool(&_MetaNodeStake.CallOpts, arg0)
+}
+
+// PoolLength is a free data retrieval call binding the contract method 0x081e3eda.
+//
+// Solidity: function poolLength() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCaller) PoolLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "poolLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PoolLength is a free data retrieval call binding the contract method 0x081e3eda.
+//
+// Solidity: function poolLength() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeSession) PoolLength() (*big.Int, error) {
+	return _MetaNodeStake.Contract.PoolLength(&_MetaNodeStake.CallOpts)
+}
+
+// PoolLength is a free data retrieval call binding the contract method 0x081e3eda.
+//
+// Solidity: function poolLength() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) PoolLength() (*big.Int, error) {
+	return _MetaNodeStake.Contract.PoolLength(&_MetaNodeStake.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_MetaNodeStake *MetaNodeStakeCaller) ProxiableUUID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "proxiableUUID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_MetaNodeStake *MetaNodeStakeSession) ProxiableUUID() ([32]byte, error) {
+	return _MetaNodeStake.Contract.ProxiableUUID(&_MetaNodeStake.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) ProxiableUUID() ([32]byte, error) {
+	return _MetaNodeStake.Contract.ProxiableUUID(&_MetaNodeStake.CallOpts)
+}
+
+// StakingBalance is a free data retrieval call binding the contract method 0x11548234.
+//
+// Solidity: function stakingBalance(uint256 _pid, address _user) view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCaller) StakingBalance(opts *bind.CallOpts, _pid *big.Int, _user common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "stakingBalance", _pid, _user)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// StakingBalance is a free data retrieval call binding the contract method 0x11548234.
+//
+// Solidity: function stakingBalance(uint256 _pid, address _user) view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeSession) StakingBalance(_pid *big.Int, _user common.Address) (*big.Int, error) {
+	return _MetaNodeStake.Contract.StakingBalance(&_MetaNodeStake.CallOpts, _pid, _user)
+}
+
+// StakingBalance is a free data retrieval call binding the contract method 0x11548234.
+//
+// Solidity: function stakingBalance(uint256 _pid, address _user) view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) StakingBalance(_pid *big.Int, _user common.Address) (*big.Int, error) {
+	return _MetaNodeStake.Contract.StakingBalance(&_MetaNodeStake.CallOpts, _pid, _user)
+}
+
+// StartBlock is a free data retrieval call binding the contract method 0x48cd4cb1.
+//
+// Solidity: function startBlock() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCaller) StartBlock(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "startBlock")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// StartBlock is a free data retrieval call binding the contract method 0x48cd4cb1.
+//
+// Solidity: function startBlock() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeSession) StartBlock() (*big.Int, error) {
+	return _MetaNodeStake.Contract.StartBlock(&_MetaNodeStake.CallOpts)
+}
+
+// StartBlock is a free data retrieval call binding the contract method 0x48cd4cb1.
+//
+// Solidity: function startBlock() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) StartBlock() (*big.Int, error) {
+	return _MetaNodeStake.Contract.StartBlock(&_MetaNodeStake.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_MetaNodeStake *MetaNodeStakeCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_MetaNodeStake *MetaNodeStakeSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _MetaNodeStake.Contract.SupportsInterface(&_MetaNodeStake.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _MetaNodeStake.Contract.SupportsInterface(&_MetaNodeStake.CallOpts, interfaceId)
+}
+
+// TotalPoolWeight is a free data retrieval call binding the contract method 0x02559004.
+//
+// Solidity: function totalPoolWeight() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCaller) TotalPoolWeight(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "totalPoolWeight")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalPoolWeight is a free data retrieval call binding the contract method 0x02559004.
+//
+// Solidity: function totalPoolWeight() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeSession) TotalPoolWeight() (*big.Int, error) {
+	return _MetaNodeStake.Contract.TotalPoolWeight(&_MetaNodeStake.CallOpts)
+}
+
+// TotalPoolWeight is a free data retrieval call binding the contract method 0x02559004.
+//
+// Solidity: function totalPoolWeight() view returns(uint256)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) TotalPoolWeight() (*big.Int, error) {
+	return _MetaNodeStake.Contract.TotalPoolWeight(&_MetaNodeStake.CallOpts)
+}
+
+// User is a free data retrieval call binding the contract method 0x37849b3c.
+//
+// Solidity: function user(uint256 , address ) view returns(uint256 stAmount, uint256 finishedMetaNode, uint256 pendingMetaNode)
+func (_MetaNodeStake *MetaNodeStakeCaller) User(opts *bind.CallOpts, arg0 *big.Int, arg1 common.Address) (struct {
+	StAmount         *big.Int
+	FinishedMetaNode *big.Int
+	PendingMetaNode  *big.Int
+}, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "user", arg0, arg1)
+
+	outstruct := new(struct {
+		StAmount         *big.Int
+		FinishedMetaNode *big.Int
+		PendingMetaNode  *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.StAmount = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.FinishedMetaNode = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.PendingMetaNode = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// User is a free data retrieval call binding the contract method 0x37849b3c.
+//
+// Solidity: function user(uint256 , address ) view returns(uint256 stAmount, uint256 finishedMetaNode, uint256 pendingMetaNode)
+func (_MetaNodeStake *MetaNodeStakeSession) User(arg0 *big.Int, arg1 common.Address) (struct {
+	StAmount         *big.Int
+	FinishedMetaNode *big.Int
+	PendingMetaNode  *big.Int
+}, error) {
+	return _MetaNodeStake.Contract.User(&_MetaNodeStake.CallOpts, arg0, arg1)
+}
+
+// User is a free data retrieval call binding the contract method 0x37849b3c.
+//
+// Solidity: function user(uint256 , address ) view returns(uint256 stAmount, uint256 finishedMetaNode, uint256 pendingMetaNode)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) User(arg0 *big.Int, arg1 common.Address) (struct {
+	StAmount         *big.Int
+	FinishedMetaNode *big.Int
+	PendingMetaNode  *big.Int
+}, error) {
+	return _MetaNodeStake.Contract.User(&_MetaNodeStake.CallOpts, arg0, arg1)
+}
+
+// WithdrawAmount is a free data retrieval call binding the contract method 0xff423357.
+//
+// Solidity: function withdrawAmount(uint256 _pid, address _user) view returns(uint256 requestAmount, uint256 pendingWithdrawAmount)
+func (_MetaNodeStake *MetaNodeStakeCaller) WithdrawAmount(opts *bind.CallOpts, _pid *big.Int, _user common.Address) (struct {
+	RequestAmount         *big.Int
+	PendingWithdrawAmount *big.Int
+}, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "withdrawAmount", _pid, _user)
+
+	outstruct := new(struct {
+		RequestAmount         *big.Int
+		PendingWithdrawAmount *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.RequestAmount = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.PendingWithdrawAmount = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// WithdrawAmount is a free data retrieval call binding the contract method 0xff423357.
+//
+// Solidity: function withdrawAmount(uint256 _pid, address _user) view returns(uint256 requestAmount, uint256 pendingWithdrawAmount)
+func (_MetaNodeStake *MetaNodeStakeSession) WithdrawAmount(_pid *big.Int, _user common.Address) (struct {
+	RequestAmount         *big.Int
+	PendingWithdrawAmount *big.Int
+}, error) {
+	return _MetaNodeStake.Contract.WithdrawAmount(&_MetaNodeStake.CallOpts, _pid, _user)
+}
+
+// WithdrawAmount is a free data retrieval call binding the contract method 0xff423357.
+//
+// Solidity: function withdrawAmount(uint256 _pid, address _user) view returns(uint256 requestAmount, uint256 pendingWithdrawAmount)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) WithdrawAmount(_pid *big.Int, _user common.Address) (struct {
+	RequestAmount         *big.Int
+	PendingWithdrawAmount *big.Int
+}, error) {
+	return _MetaNodeStake.Contract.WithdrawAmount(&_MetaNodeStake.CallOpts, _pid, _user)
+}
+
+// WithdrawPaused is a free data retrieval call binding the contract method 0x2f3ffb9f.
+//
+// Solidity: function withdrawPaused() view returns(bool)
+func (_MetaNodeStake *MetaNodeStakeCaller) WithdrawPaused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _MetaNodeStake.contract.Call(opts, &out, "withdrawPaused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// WithdrawPaused is a free data retrieval call binding the contract method 0x2f3ffb9f.
+//
+// Solidity: function withdrawPaused() view returns(bool)
+func (_MetaNodeStake *MetaNodeStakeSession) WithdrawPaused() (bool, error) {
+	return _MetaNodeStake.Contract.WithdrawPaused(&_MetaNodeStake.CallOpts)
+}
+
+// WithdrawPaused is a free data retrieval call binding the contract method 0x2f3ffb9f.
+//
+// Solidity: function withdrawPaused() view returns(bool)
+func (_MetaNodeStake *MetaNodeStakeCallerSession) WithdrawPaused() (bool, error) {
+	return _MetaNodeStake.Contract.WithdrawPaused(&_MetaNodeStake.CallOpts)
+}
+
+// AddPool is a paid mutator transaction binding the contract method 0xb6d9d919.
+//
+// Solidity: function addPool(address _stTokenAddress, uint256 _poolWeight, uint256 _minDepositAmount, uint256 _unstakeLockedBlocks, bool _withUpdate) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) AddPool(opts *bind.TransactOpts, _stTokenAddress common.Address, _poolWeight *big.Int, _minDepositAmount *big.Int, _unstakeLockedBlocks *big.Int, _withUpdate bool) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "addPool", _stTokenAddress, _poolWeight, _minDepositAmount, _unstakeLockedBlocks, _withUpdate)
+}
+
+// AddPool is a paid mutator transaction binding the contract method 0xb6d9d919.
+//
+// Solidity: function addPool(address _stTokenAddress, uint256 _poolWeight, uint256 _minDepositAmount, uint256 _unstakeLockedBlocks, bool _withUpdate) returns()
+func (_MetaNodeStake *MetaNodeStakeSession) AddPool(_stTokenAddress common.Address, _poolWeight *big.Int, _minDepositAmount *big.Int, _unstakeLockedBlocks *big.Int, _withUpdate bool) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.AddPool(&_MetaNodeStake.TransactOpts, _stTokenAddress, _poolWeight, _minDepositAmount, _unstakeLockedBlocks, _withUpdate)
+}
+
+// AddPool is a paid mutator transaction binding the contract method 0xb6d9d919.
+//
+// Solidity: function addPool(address _stTokenAddress, uint256 _poolWeight, uint256 _minDepositAmount, uint256 _unstakeLockedBlocks, bool _withUpdate) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) AddPool(_stTokenAddress common.Address, _poolWeight *big.Int, _minDepositAmount *big.Int, _unstakeLockedBlocks *big.Int, _withUpdate bool) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.AddPool(&_MetaNodeStake.TransactOpts, _stTokenAddress, _poolWeight, _minDepositAmount, _unstakeLockedBlocks, _withUpdate)
+}
+
+// Claim is a paid mutator transaction binding the contract method 0x379607f5.
+//
+// Solidity: function claim(uint256 _pid) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) Claim(opts *bind.TransactOpts, _pid *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "claim", _pid)
+}
+
+// Claim is a paid mutator transaction binding the contract method 0x379607f5.
+//
+// Solidity: function claim(uint256 _pid) returns()
+func (_MetaNodeStake *MetaNodeStakeSession) Claim(_pid *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.Claim(&_MetaNodeStake.TransactOpts, _pid)
+}
+
+// Claim is a paid mutator transaction binding the contract method 0x379607f5.
+//
+// Solidity: function claim(uint256 _pid) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) Claim(_pid *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.Claim(&_MetaNodeStake.TransactOpts, _pid)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xe2bbb158.
+//
+// Solidity: function deposit(uint256 _pid, uint256 _amount) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) Deposit(opts *bind.TransactOpts, _pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "deposit", _pid, _amount)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xe2bbb158.
+//
+// Solidity: function deposit(uint256 _pid, uint256 _amount) returns()
+func (_MetaNodeStake *MetaNodeStakeSession) Deposit(_pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.Deposit(&_MetaNodeStake.TransactOpts, _pid, _amount)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0xe2bbb158.
+//
+// Solidity: function deposit(uint256 _pid, uint256 _amount) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) Deposit(_pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.Deposit(&_MetaNodeStake.TransactOpts, _pid, _amount)
+}
+
+// DepositETH is a paid mutator transaction binding the contract method 0xf6326fb3.
+//
+// Solidity: function depositETH() payable returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) DepositETH(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "depositETH")
+}
+
+// DepositETH is a paid mutator transaction binding the contract method 0xf6326fb3.
+//
+// Solidity: function depositETH() payable returns()
+func (_MetaNodeStake *MetaNodeStakeSession) DepositETH() (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.DepositETH(&_MetaNodeStake.TransactOpts)
+}
+
+// DepositETH is a paid mutator transaction binding the contract method 0xf6326fb3.
+//
+// Solidity: function depositETH() payable returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) DepositETH() (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.DepositETH(&_MetaNodeStake.TransactOpts)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_MetaNodeStake *MetaNodeStakeSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.GrantRole(&_MetaNodeStake.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.GrantRole(&_MetaNodeStake.TransactOpts, role, account)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x4ec81af1.
+//
+// Solidity: function initialize(address _MetaNode, uint256 _startBlock, uint256 _endBlock, uint256 _MetaNodePerBlock) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) Initialize(opts *bind.TransactOpts, _MetaNode common.Address, _startBlock *big.Int, _endBlock *big.Int, _MetaNodePerBlock *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "initialize", _MetaNode, _startBlock, _endBlock, _MetaNodePerBlock)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x4ec81af1.
+//
+// Solidity: function initialize(address _MetaNode, uint256 _startBlock, uint256 _endBlock, uint256 _MetaNodePerBlock) returns()
+func (_MetaNodeStake *MetaNodeStakeSession) Initialize(_MetaNode common.Address, _startBlock *big.Int, _endBlock *big.Int, _MetaNodePerBlock *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.Initialize(&_MetaNodeStake.TransactOpts, _MetaNode, _startBlock, _endBlock, _MetaNodePerBlock)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x4ec81af1.
+//
+// Solidity: function initialize(address _MetaNode, uint256 _startBlock, uint256 _endBlock, uint256 _MetaNodePerBlock) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) Initialize(_MetaNode common.Address, _startBlock *big.Int, _endBlock *big.Int, _MetaNodePerBlock *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.Initialize(&_MetaNodeStake.TransactOpts, _MetaNode, _startBlock, _endBlock, _MetaNodePerBlock)
+}
+
+// MassUpdatePools is a paid mutator transaction binding the contract method 0x630b5ba1.
+//
+// Solidity: function massUpdatePools() returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) MassUpdatePools(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "massUpdatePools")
+}
+
+// MassUpdatePools is a paid mutator transaction binding the contract method 0x630b5ba1.
+//
+// Solidity: function massUpdatePools() returns()
+func (_MetaNodeStake *MetaNodeStakeSession) MassUpdatePools() (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.MassUpdatePools(&_MetaNodeStake.TransactOpts)
+}
+
+// MassUpdatePools is a paid mutator transaction binding the contract method 0x630b5ba1.
+//
+// Solidity: function massUpdatePools() returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) MassUpdatePools() (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.MassUpdatePools(&_MetaNodeStake.TransactOpts)
+}
+
+// PauseClaim is a paid mutator transaction binding the contract method 0x8ff095f9.
+//
+// Solidity: function pauseClaim() returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) PauseClaim(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "pauseClaim")
+}
+
+// PauseClaim is a paid mutator transaction binding the contract method 0x8ff095f9.
+//
+// Solidity: function pauseClaim() returns()
+func (_MetaNodeStake *MetaNodeStakeSession) PauseClaim() (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.PauseClaim(&_MetaNodeStake.TransactOpts)
+}
+
+// PauseClaim is a paid mutator transaction binding the contract method 0x8ff095f9.
+//
+// Solidity: function pauseClaim() returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) PauseClaim() (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.PauseClaim(&_MetaNodeStake.TransactOpts)
+}
+
+// PauseWithdraw is a paid mutator transaction binding the contract method 0x6155e3de.
+//
+// Solidity: function pauseWithdraw() returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) PauseWithdraw(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "pauseWithdraw")
+}
+
+// PauseWithdraw is a paid mutator transaction binding the contract method 0x6155e3de.
+//
+// Solidity: function pauseWithdraw() returns()
+func (_MetaNodeStake *MetaNodeStakeSession) PauseWithdraw() (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.PauseWithdraw(&_MetaNodeStake.TransactOpts)
+}
+
+// PauseWithdraw is a paid mutator transaction binding the contract method 0x6155e3de.
+//
+// Solidity: function pauseWithdraw() returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) PauseWithdraw() (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.PauseWithdraw(&_MetaNodeStake.TransactOpts)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "renounceRole", role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_MetaNodeStake *MetaNodeStakeSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.RenounceRole(&_MetaNodeStake.TransactOpts, role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.RenounceRole(&_MetaNodeStake.TransactOpts, role, callerConfirmation)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_MetaNodeStake *MetaNodeStakeSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.RevokeRole(&_MetaNodeStake.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.RevokeRole(&_MetaNodeStake.TransactOpts, role, account)
+}
+
+// SetEndBlock is a paid mutator transaction binding the contract method 0xc713aa94.
+//
+// Solidity: function setEndBlock(uint256 _endBlock) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) SetEndBlock(opts *bind.TransactOpts, _endBlock *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "setEndBlock", _endBlock)
+}
+
+// SetEndBlock is a paid mutator transaction binding the contract method 0xc713aa94.
+//
+// Solidity: function setEndBlock(uint256 _endBlock) returns()
+func (_MetaNodeStake *MetaNodeStakeSession) SetEndBlock(_endBlock *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.SetEndBlock(&_MetaNodeStake.TransactOpts, _endBlock)
+}
+
+// SetEndBlock is a paid mutator transaction binding the contract method 0xc713aa94.
+//
+// Solidity: function setEndBlock(uint256 _endBlock) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) SetEndBlock(_endBlock *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.SetEndBlock(&_MetaNodeStake.TransactOpts, _endBlock)
+}
+
+// SetMetaNode is a paid mutator transaction binding the contract method 0x61a9a03d.
+//
+// Solidity: function setMetaNode(address _MetaNode) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) SetMetaNode(opts *bind.TransactOpts, _MetaNode common.Address) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "setMetaNode", _MetaNode)
+}
+
+// SetMetaNode is a paid mutator transaction binding the contract method 0x61a9a03d.
+//
+// Solidity: function setMetaNode(address _MetaNode) returns()
+func (_MetaNodeStake *MetaNodeStakeSession) SetMetaNode(_MetaNode common.Address) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.SetMetaNode(&_MetaNodeStake.TransactOpts, _MetaNode)
+}
+
+// SetMetaNode is a paid mutator transaction binding the contract method 0x61a9a03d.
+//
+// Solidity: function setMetaNode(address _MetaNode) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) SetMetaNode(_MetaNode common.Address) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.SetMetaNode(&_MetaNodeStake.TransactOpts, _MetaNode)
+}
+
+// SetMetaNodePerBlock is a paid mutator transaction binding the contract method 0xd7bc1769.
+//
+// Solidity: function setMetaNodePerBlock(uint256 _MetaNodePerBlock) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) SetMetaNodePerBlock(opts *bind.TransactOpts, _MetaNodePerBlock *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "setMetaNodePerBlock", _MetaNodePerBlock)
+}
+
+// SetMetaNodePerBlock is a paid mutator transaction binding the contract method 0xd7bc1769.
+//
+// Solidity: function setMetaNodePerBlock(uint256 _MetaNodePerBlock) returns()
+func (_MetaNodeStake *MetaNodeStakeSession) SetMetaNodePerBlock(_MetaNodePerBlock *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.SetMetaNodePerBlock(&_MetaNodeStake.TransactOpts, _MetaNodePerBlock)
+}
+
+// SetMetaNodePerBlock is a paid mutator transaction binding the contract method 0xd7bc1769.
+//
+// Solidity: function setMetaNodePerBlock(uint256 _MetaNodePerBlock) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) SetMetaNodePerBlock(_MetaNodePerBlock *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.SetMetaNodePerBlock(&_MetaNodeStake.TransactOpts, _MetaNodePerBlock)
+}
+
+// SetPoolWeight is a paid mutator transaction binding the contract method 0xfad07ece.
+//
+// Solidity: function setPoolWeight(uint256 _pid, uint256 _poolWeight, bool _withUpdate) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) SetPoolWeight(opts *bind.TransactOpts, _pid *big.Int, _poolWeight *big.Int, _withUpdate bool) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "setPoolWeight", _pid, _poolWeight, _withUpdate)
+}
+
+// SetPoolWeight is a paid mutator transaction binding the contract method 0xfad07ece.
+//
+// Solidity: function setPoolWeight(uint256 _pid, uint256 _poolWeight, bool _withUpdate) returns()
+func (_MetaNodeStake *MetaNodeStakeSession) SetPoolWeight(_pid *big.Int, _poolWeight *big.Int, _withUpdate bool) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.SetPoolWeight(&_MetaNodeStake.TransactOpts, _pid, _poolWeight, _withUpdate)
+}
+
+// SetPoolWeight is a paid mutator transaction binding the contract method 0xfad07ece.
+//
+// Solidity: function setPoolWeight(uint256 _pid, uint256 _poolWeight, bool _withUpdate) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) SetPoolWeight(_pid *big.Int, _poolWeight *big.Int, _withUpdate bool) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.SetPoolWeight(&_MetaNodeStake.TransactOpts, _pid, _poolWeight, _withUpdate)
+}
+
+// SetStartBlock is a paid mutator transaction binding the contract method 0xf35e4a6e.
+//
+// Solidity: function setStartBlock(uint256 _startBlock) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) SetStartBlock(opts *bind.TransactOpts, _startBlock *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "setStartBlock", _startBlock)
+}
+
+// SetStartBlock is a paid mutator transaction binding the contract method 0xf35e4a6e.
+//
+// Solidity: function setStartBlock(uint256 _startBlock) returns()
+func (_MetaNodeStake *MetaNodeStakeSession) SetStartBlock(_startBlock *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.SetStartBlock(&_MetaNodeStake.TransactOpts, _startBlock)
+}
+
+// SetStartBlock is a paid mutator transaction binding the contract method 0xf35e4a6e.
+//
+// Solidity: function setStartBlock(uint256 _startBlock) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) SetStartBlock(_startBlock *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.SetStartBlock(&_MetaNodeStake.TransactOpts, _startBlock)
+}
+
+// UnpauseClaim is a paid mutator transaction binding the contract method 0xde065caa.
+//
+// Solidity: function unpauseClaim() returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) UnpauseClaim(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "unpauseClaim")
+}
+
+// UnpauseClaim is a paid mutator transaction binding the contract method 0xde065caa.
+//
+// Solidity: function unpauseClaim() returns()
+func (_MetaNodeStake *MetaNodeStakeSession) UnpauseClaim() (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.UnpauseClaim(&_MetaNodeStake.TransactOpts)
+}
+
+// UnpauseClaim is a paid mutator transaction binding the contract method 0xde065caa.
+//
+// Solidity: function unpauseClaim() returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) UnpauseClaim() (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.UnpauseClaim(&_MetaNodeStake.TransactOpts)
+}
+
+// UnpauseWithdraw is a paid mutator transaction binding the contract method 0x5bb6d007.
+//
+// Solidity: function unpauseWithdraw() returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) UnpauseWithdraw(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "unpauseWithdraw")
+}
+
+// UnpauseWithdraw is a paid mutator transaction binding the contract method 0x5bb6d007.
+//
+// Solidity: function unpauseWithdraw() returns()
+func (_MetaNodeStake *MetaNodeStakeSession) UnpauseWithdraw() (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.UnpauseWithdraw(&_MetaNodeStake.TransactOpts)
+}
+
+// UnpauseWithdraw is a paid mutator transaction binding the contract method 0x5bb6d007.
+//
+// Solidity: function unpauseWithdraw() returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) UnpauseWithdraw() (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.UnpauseWithdraw(&_MetaNodeStake.TransactOpts)
+}
+
+// Unstake is a paid mutator transaction binding the contract method 0x9e2c8a5b.
+//
+// Solidity: function unstake(uint256 _pid, uint256 _amount) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) Unstake(opts *bind.TransactOpts, _pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "unstake", _pid, _amount)
+}
+
+// Unstake is a paid mutator transaction binding the contract method 0x9e2c8a5b.
+//
+// Solidity: function unstake(uint256 _pid, uint256 _amount) returns()
+func (_MetaNodeStake *MetaNodeStakeSession) Unstake(_pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.Unstake(&_MetaNodeStake.TransactOpts, _pid, _amount)
+}
+
+// Unstake is a paid mutator transaction binding the contract method 0x9e2c8a5b.
+//
+// Solidity: function unstake(uint256 _pid, uint256 _amount) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) Unstake(_pid *big.Int, _amount *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.Unstake(&_MetaNodeStake.TransactOpts, _pid, _amount)
+}
+
+// UpdatePool is a paid mutator transaction binding the contract method 0x51eb05a6.
+//
+// Solidity: function updatePool(uint256 _pid) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) UpdatePool(opts *bind.TransactOpts, _pid *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "updatePool", _pid)
+}
+
+// UpdatePool is a paid mutator transaction binding the contract method 0x51eb05a6.
+//
+// Solidity: function updatePool(uint256 _pid) returns()
+func (_MetaNodeStake *MetaNodeStakeSession) UpdatePool(_pid *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.UpdatePool(&_MetaNodeStake.TransactOpts, _pid)
+}
+
+// UpdatePool is a paid mutator transaction binding the contract method 0x51eb05a6.
+//
+// Solidity: function updatePool(uint256 _pid) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) UpdatePool(_pid *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.UpdatePool(&_MetaNodeStake.TransactOpts, _pid)
+}
+
+// UpdatePool0 is a paid mutator transaction binding the contract method 0xd86c0444.
+//
+// Solidity: function updatePool(uint256 _pid, uint256 _minDepositAmount, uint256 _unstakeLockedBlocks) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) UpdatePool0(opts *bind.TransactOpts, _pid *big.Int, _minDepositAmount *big.Int, _unstakeLockedBlocks *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "updatePool0", _pid, _minDepositAmount, _unstakeLockedBlocks)
+}
+
+// UpdatePool0 is a paid mutator transaction binding the contract method 0xd86c0444.
+//
+// Solidity: function updatePool(uint256 _pid, uint256 _minDepositAmount, uint256 _unstakeLockedBlocks) returns()
+func (_MetaNodeStake *MetaNodeStakeSession) UpdatePool0(_pid *big.Int, _minDepositAmount *big.Int, _unstakeLockedBlocks *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.UpdatePool0(&_MetaNodeStake.TransactOpts, _pid, _minDepositAmount, _unstakeLockedBlocks)
+}
+
+// UpdatePool0 is a paid mutator transaction binding the contract method 0xd86c0444.
+//
+// Solidity: function updatePool(uint256 _pid, uint256 _minDepositAmount, uint256 _unstakeLockedBlocks) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) UpdatePool0(_pid *big.Int, _minDepositAmount *big.Int, _unstakeLockedBlocks *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.UpdatePool0(&_MetaNodeStake.TransactOpts, _pid, _minDepositAmount, _unstakeLockedBlocks)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_MetaNodeStake *MetaNodeStakeSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.UpgradeToAndCall(&_MetaNodeStake.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.UpgradeToAndCall(&_MetaNodeStake.TransactOpts, newImplementation, data)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 _pid) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactor) Withdraw(opts *bind.TransactOpts, _pid *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.contract.Transact(opts, "withdraw", _pid)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 _pid) returns()
+func (_MetaNodeStake *MetaNodeStakeSession) Withdraw(_pid *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.Withdraw(&_MetaNodeStake.TransactOpts, _pid)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 _pid) returns()
+func (_MetaNodeStake *MetaNodeStakeTransactorSession) Withdraw(_pid *big.Int) (*types.Transaction, error) {
+	return _MetaNodeStake.Contract.Withdraw(&_MetaNodeStake.TransactOpts, _pid)
+}
+
+// MetaNodeStakeAddPoolIterator is returned from FilterAddPool and is used to iterate over the raw logs and unpacked data for AddPool events raised by the MetaNodeStake contract.
+type MetaNodeStakeAddPoolIterator struct {
+	Event *MetaNodeStakeAddPool // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeAddPoolIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeAddPool)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeAddPool)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeAddPoolIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeAddPoolIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeAddPool represents a AddPool event raised by the MetaNodeStake contract.
+type MetaNodeStakeAddPool struct {
+	StTokenAddress      common.Address
+	PoolWeight          *big.Int
+	LastRewardBlock     *big.Int
+	MinDepositAmount    *big.Int
+	UnstakeLockedBlocks *big.Int
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterAddPool is a free log retrieval operation binding the contract event 0x0fa296fce13e7a0e622b3a892e66220c248337289483a3cfa4130cde0caa1346.
+//
+// Solidity: event AddPool(address indexed stTokenAddress, uint256 indexed poolWeight, uint256 indexed lastRewardBlock, uint256 minDepositAmount, uint256 unstakeLockedBlocks)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterAddPool(opts *bind.FilterOpts, stTokenAddress []common.Address, poolWeight []*big.Int, lastRewardBlock []*big.Int) (*MetaNodeStakeAddPoolIterator, error) {
+
+	var stTokenAddressRule []interface{}
+	for _, stTokenAddressItem := range stTokenAddress {
+		stTokenAddressRule = append(stTokenAddressRule, stTokenAddressItem)
+	}
+	var poolWeightRule []interface{}
+	for _, poolWeightItem := range poolWeight {
+		poolWeightRule = append(poolWeightRule, poolWeightItem)
+	}
+	var lastRewardBlockRule []interface{}
+	for _, lastRewardBlockItem := range lastRewardBlock {
+		lastRewardBlockRule = append(lastRewardBlockRule, lastRewardBlockItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "AddPool", stTokenAddressRule, poolWeightRule, lastRewardBlockRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeAddPoolIterator{contract: _MetaNodeStake.contract, event: "AddPool", logs: logs, sub: sub}, nil
+}
+
+// WatchAddPool is a free log subscription operation binding the contract event 0x0fa296fce13e7a0e622b3a892e66220c248337289483a3cfa4130cde0caa1346.
+//
+// Solidity: event AddPool(address indexed stTokenAddress, uint256 indexed poolWeight, uint256 indexed lastRewardBlock, uint256 minDepositAmount, uint256 unstakeLockedBlocks)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchAddPool(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeAddPool, stTokenAddress []common.Address, poolWeight []*big.Int, lastRewardBlock []*big.Int) (event.Subscription, error) {
+
+	var stTokenAddressRule []interface{}
+	for _, stTokenAddressItem := range stTokenAddress {
+		stTokenAddressRule = append(stTokenAddressRule, stTokenAddressItem)
+	}
+	var poolWeightRule []interface{}
+	for _, poolWeightItem := range poolWeight {
+		poolWeightRule = append(poolWeightRule, poolWeightItem)
+	}
+	var lastRewardBlockRule []interface{}
+	for _, lastRewardBlockItem := range lastRewardBlock {
+		lastRewardBlockRule = append(lastRewardBlockRule, lastRewardBlockItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "AddPool", stTokenAddressRule, poolWeightRule, lastRewardBlockRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeAddPool)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "AddPool", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAddPool is a log parse operation binding the contract event 0x0fa296fce13e7a0e622b3a892e66220c248337289483a3cfa4130cde0caa1346.
+//
+// Solidity: event AddPool(address indexed stTokenAddress, uint256 indexed poolWeight, uint256 indexed lastRewardBlock, uint256 minDepositAmount, uint256 unstakeLockedBlocks)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseAddPool(log types.Log) (*MetaNodeStakeAddPool, error) {
+	event := new(MetaNodeStakeAddPool)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "AddPool", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeClaimIterator is returned from FilterClaim and is used to iterate over the raw logs and unpacked data for Claim events raised by the MetaNodeStake contract.
+type MetaNodeStakeClaimIterator struct {
+	Event *MetaNodeStakeClaim // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeClaimIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeClaim)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeClaim)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeClaimIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeClaimIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeClaim represents a Claim event raised by the MetaNodeStake contract.
+type MetaNodeStakeClaim struct {
+	User           common.Address
+	PoolId         *big.Int
+	MetaNodeReward *big.Int
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterClaim is a free log retrieval operation binding the contract event 0x34fcbac0073d7c3d388e51312faf357774904998eeb8fca628b9e6f65ee1cbf7.
+//
+// Solidity: event Claim(address indexed user, uint256 indexed poolId, uint256 MetaNodeReward)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterClaim(opts *bind.FilterOpts, user []common.Address, poolId []*big.Int) (*MetaNodeStakeClaimIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var poolIdRule []interface{}
+	for _, poolIdItem := range poolId {
+		poolIdRule = append(poolIdRule, poolIdItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "Claim", userRule, poolIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeClaimIterator{contract: _MetaNodeStake.contract, event: "Claim", logs: logs, sub: sub}, nil
+}
+
+// WatchClaim is a free log subscription operation binding the contract event 0x34fcbac0073d7c3d388e51312faf357774904998eeb8fca628b9e6f65ee1cbf7.
+//
+// Solidity: event Claim(address indexed user, uint256 indexed poolId, uint256 MetaNodeReward)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchClaim(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeClaim, user []common.Address, poolId []*big.Int) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var poolIdRule []interface{}
+	for _, poolIdItem := range poolId {
+		poolIdRule = append(poolIdRule, poolIdItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "Claim", userRule, poolIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeClaim)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "Claim", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseClaim is a log parse operation binding the contract event 0x34fcbac0073d7c3d388e51312faf357774904998eeb8fca628b9e6f65ee1cbf7.
+//
+// Solidity: event Claim(address indexed user, uint256 indexed poolId, uint256 MetaNodeReward)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseClaim(log types.Log) (*MetaNodeStakeClaim, error) {
+	event := new(MetaNodeStakeClaim)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "Claim", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeDepositIterator is returned from FilterDeposit and is used to iterate over the raw logs and unpacked data for Deposit events raised by the MetaNodeStake contract.
+type MetaNodeStakeDepositIterator struct {
+	Event *MetaNodeStakeDeposit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeDepositIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeDeposit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeDeposit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeDepositIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeDepositIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeDeposit represents a Deposit event raised by the MetaNodeStake contract.
+type MetaNodeStakeDeposit struct {
+	User   common.Address
+	PoolId *big.Int
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeposit is a free log retrieval operation binding the contract event 0x90890809c654f11d6e72a28fa60149770a0d11ec6c92319d6ceb2bb0a4ea1a15.
+//
+// Solidity: event Deposit(address indexed user, uint256 indexed poolId, uint256 amount)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterDeposit(opts *bind.FilterOpts, user []common.Address, poolId []*big.Int) (*MetaNodeStakeDepositIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var poolIdRule []interface{}
+	for _, poolIdItem := range poolId {
+		poolIdRule = append(poolIdRule, poolIdItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "Deposit", userRule, poolIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeDepositIterator{contract: _MetaNodeStake.contract, event: "Deposit", logs: logs, sub: sub}, nil
+}
+
+// WatchDeposit is a free log subscription operation binding the contract event 0x90890809c654f11d6e72a28fa60149770a0d11ec6c92319d6ceb2bb0a4ea1a15.
+//
+// Solidity: event Deposit(address indexed user, uint256 indexed poolId, uint256 amount)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchDeposit(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeDeposit, user []common.Address, poolId []*big.Int) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var poolIdRule []interface{}
+	for _, poolIdItem := range poolId {
+		poolIdRule = append(poolIdRule, poolIdItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "Deposit", userRule, poolIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeDeposit)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "Deposit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeposit is a log parse operation binding the contract event 0x90890809c654f11d6e72a28fa60149770a0d11ec6c92319d6ceb2bb0a4ea1a15.
+//
+// Solidity: event Deposit(address indexed user, uint256 indexed poolId, uint256 amount)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseDeposit(log types.Log) (*MetaNodeStakeDeposit, error) {
+	event := new(MetaNodeStakeDeposit)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "Deposit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the MetaNodeStake contract.
+type MetaNodeStakeInitializedIterator struct {
+	Event *MetaNodeStakeInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeInitialized represents a Initialized event raised by the MetaNodeStake contract.
+type MetaNodeStakeInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterInitialized(opts *bind.FilterOpts) (*MetaNodeStakeInitializedIterator, error) {
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeInitializedIterator{contract: _MetaNodeStake.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeInitialized)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseInitialized(log types.Log) (*MetaNodeStakeInitialized, error) {
+	event := new(MetaNodeStakeInitialized)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakePauseClaimIterator is returned from FilterPauseClaim and is used to iterate over the raw logs and unpacked data for PauseClaim events raised by the MetaNodeStake contract.
+type MetaNodeStakePauseClaimIterator struct {
+	Event *MetaNodeStakePauseClaim // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakePauseClaimIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakePauseClaim)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakePauseClaim)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakePauseClaimIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakePauseClaimIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakePauseClaim represents a PauseClaim event raised by the MetaNodeStake contract.
+type MetaNodeStakePauseClaim struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterPauseClaim is a free log retrieval operation binding the contract event 0x6d73d6b34c378ab3bf6630206d60b7882801b91d03ee20d016ff0d5054db81e1.
+//
+// Solidity: event PauseClaim()
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterPauseClaim(opts *bind.FilterOpts) (*MetaNodeStakePauseClaimIterator, error) {
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "PauseClaim")
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakePauseClaimIterator{contract: _MetaNodeStake.contract, event: "PauseClaim", logs: logs, sub: sub}, nil
+}
+
+// WatchPauseClaim is a free log subscription operation binding the contract event 0x6d73d6b34c378ab3bf6630206d60b7882801b91d03ee20d016ff0d5054db81e1.
+//
+// Solidity: event PauseClaim()
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchPauseClaim(opts *bind.WatchOpts, sink chan<- *MetaNodeStakePauseClaim) (event.Subscription, error) {
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "PauseClaim")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakePauseClaim)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "PauseClaim", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePauseClaim is a log parse operation binding the contract event 0x6d73d6b34c378ab3bf6630206d60b7882801b91d03ee20d016ff0d5054db81e1.
+//
+// Solidity: event PauseClaim()
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParsePauseClaim(log types.Log) (*MetaNodeStakePauseClaim, error) {
+	event := new(MetaNodeStakePauseClaim)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "PauseClaim", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakePauseWithdrawIterator is returned from FilterPauseWithdraw and is used to iterate over the raw logs and unpacked data for PauseWithdraw events raised by the MetaNodeStake contract.
+type MetaNodeStakePauseWithdrawIterator struct {
+	Event *MetaNodeStakePauseWithdraw // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakePauseWithdrawIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakePauseWithdraw)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakePauseWithdraw)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakePauseWithdrawIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakePauseWithdrawIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakePauseWithdraw represents a PauseWithdraw event raised by the MetaNodeStake contract.
+type MetaNodeStakePauseWithdraw struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterPauseWithdraw is a free log retrieval operation binding the contract event 0x8099f593a6aaecd68b6494933cd71f703376ac3975be83692e1b7d800abf6837.
+//
+// Solidity: event PauseWithdraw()
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterPauseWithdraw(opts *bind.FilterOpts) (*MetaNodeStakePauseWithdrawIterator, error) {
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "PauseWithdraw")
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakePauseWithdrawIterator{contract: _MetaNodeStake.contract, event: "PauseWithdraw", logs: logs, sub: sub}, nil
+}
+
+// WatchPauseWithdraw is a free log subscription operation binding the contract event 0x8099f593a6aaecd68b6494933cd71f703376ac3975be83692e1b7d800abf6837.
+//
+// Solidity: event PauseWithdraw()
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchPauseWithdraw(opts *bind.WatchOpts, sink chan<- *MetaNodeStakePauseWithdraw) (event.Subscription, error) {
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "PauseWithdraw")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakePauseWithdraw)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "PauseWithdraw", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePauseWithdraw is a log parse operation binding the contract event 0x8099f593a6aaecd68b6494933cd71f703376ac3975be83692e1b7d800abf6837.
+//
+// Solidity: event PauseWithdraw()
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParsePauseWithdraw(log types.Log) (*MetaNodeStakePauseWithdraw, error) {
+	event := new(MetaNodeStakePauseWithdraw)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "PauseWithdraw", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakePausedIterator is returned from FilterPaused and is used to iterate over the raw logs and unpacked data for Paused events raised by the MetaNodeStake contract.
+type MetaNodeStakePausedIterator struct {
+	Event *MetaNodeStakePaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakePausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakePaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakePaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakePausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakePausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakePaused represents a Paused event raised by the MetaNodeStake contract.
+type MetaNodeStakePaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPaused is a free log retrieval operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterPaused(opts *bind.FilterOpts) (*MetaNodeStakePausedIterator, error) {
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakePausedIterator{contract: _MetaNodeStake.contract, event: "Paused", logs: logs, sub: sub}, nil
+}
+
+// WatchPaused is a free log subscription operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchPaused(opts *bind.WatchOpts, sink chan<- *MetaNodeStakePaused) (event.Subscription, error) {
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "Paused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakePaused)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "Paused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePaused is a log parse operation binding the contract event 0x62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258.
+//
+// Solidity: event Paused(address account)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParsePaused(log types.Log) (*MetaNodeStakePaused, error) {
+	event := new(MetaNodeStakePaused)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "Paused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeRequestUnstakeIterator is returned from FilterRequestUnstake and is used to iterate over the raw logs and unpacked data for RequestUnstake events raised by the MetaNodeStake contract.
+type MetaNodeStakeRequestUnstakeIterator struct {
+	Event *MetaNodeStakeRequestUnstake // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeRequestUnstakeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeRequestUnstake)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeRequestUnstake)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeRequestUnstakeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeRequestUnstakeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeRequestUnstake represents a RequestUnstake event raised by the MetaNodeStake contract.
+type MetaNodeStakeRequestUnstake struct {
+	User   common.Address
+	PoolId *big.Int
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterRequestUnstake is a free log retrieval operation binding the contract event 0xc80277265097707f6f12a4ac4c09d46c9926e2eea2536f63616cb04d9fcad7d6.
+//
+// Solidity: event RequestUnstake(address indexed user, uint256 indexed poolId, uint256 amount)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterRequestUnstake(opts *bind.FilterOpts, user []common.Address, poolId []*big.Int) (*MetaNodeStakeRequestUnstakeIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var poolIdRule []interface{}
+	for _, poolIdItem := range poolId {
+		poolIdRule = append(poolIdRule, poolIdItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "RequestUnstake", userRule, poolIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeRequestUnstakeIterator{contract: _MetaNodeStake.contract, event: "RequestUnstake", logs: logs, sub: sub}, nil
+}
+
+// WatchRequestUnstake is a free log subscription operation binding the contract event 0xc80277265097707f6f12a4ac4c09d46c9926e2eea2536f63616cb04d9fcad7d6.
+//
+// Solidity: event RequestUnstake(address indexed user, uint256 indexed poolId, uint256 amount)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchRequestUnstake(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeRequestUnstake, user []common.Address, poolId []*big.Int) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var poolIdRule []interface{}
+	for _, poolIdItem := range poolId {
+		poolIdRule = append(poolIdRule, poolIdItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "RequestUnstake", userRule, poolIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeRequestUnstake)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "RequestUnstake", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRequestUnstake is a log parse operation binding the contract event 0xc80277265097707f6f12a4ac4c09d46c9926e2eea2536f63616cb04d9fcad7d6.
+//
+// Solidity: event RequestUnstake(address indexed user, uint256 indexed poolId, uint256 amount)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseRequestUnstake(log types.Log) (*MetaNodeStakeRequestUnstake, error) {
+	event := new(MetaNodeStakeRequestUnstake)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "RequestUnstake", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the MetaNodeStake contract.
+type MetaNodeStakeRoleAdminChangedIterator struct {
+	Event *MetaNodeStakeRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeRoleAdminChanged represents a RoleAdminChanged event raised by the MetaNodeStake contract.
+type MetaNodeStakeRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*MetaNodeStakeRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeRoleAdminChangedIterator{contract: _MetaNodeStake.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeRoleAdminChanged)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseRoleAdminChanged(log types.Log) (*MetaNodeStakeRoleAdminChanged, error) {
+	event := new(MetaNodeStakeRoleAdminChanged)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the MetaNodeStake contract.
+type MetaNodeStakeRoleGrantedIterator struct {
+	Event *MetaNodeStakeRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeRoleGranted represents a RoleGranted event raised by the MetaNodeStake contract.
+type MetaNodeStakeRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*MetaNodeStakeRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeRoleGrantedIterator{contract: _MetaNodeStake.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeRoleGranted)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseRoleGranted(log types.Log) (*MetaNodeStakeRoleGranted, error) {
+	event := new(MetaNodeStakeRoleGranted)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the MetaNodeStake contract.
+type MetaNodeStakeRoleRevokedIterator struct {
+	Event *MetaNodeStakeRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeRoleRevoked represents a RoleRevoked event raised by the MetaNodeStake contract.
+type MetaNodeStakeRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*MetaNodeStakeRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeRoleRevokedIterator{contract: _MetaNodeStake.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeRoleRevoked)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseRoleRevoked(log types.Log) (*MetaNodeStakeRoleRevoked, error) {
+	event := new(MetaNodeStakeRoleRevoked)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeSetEndBlockIterator is returned from FilterSetEndBlock and is used to iterate over the raw logs and unpacked data for SetEndBlock events raised by the MetaNodeStake contract.
+type MetaNodeStakeSetEndBlockIterator struct {
+	Event *MetaNodeStakeSetEndBlock // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeSetEndBlockIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeSetEndBlock)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeSetEndBlock)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeSetEndBlockIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeSetEndBlockIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeSetEndBlock represents a SetEndBlock event raised by the MetaNodeStake contract.
+type MetaNodeStakeSetEndBlock struct {
+	EndBlock *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetEndBlock is a free log retrieval operation binding the contract event 0x1132c5baccb51da3d049fabc819697dc845fa224ad59d9b555507d6446b40850.
+//
+// Solidity: event SetEndBlock(uint256 indexed endBlock)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterSetEndBlock(opts *bind.FilterOpts, endBlock []*big.Int) (*MetaNodeStakeSetEndBlockIterator, error) {
+
+	var endBlockRule []interface{}
+	for _, endBlockItem := range endBlock {
+		endBlockRule = append(endBlockRule, endBlockItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "SetEndBlock", endBlockRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeSetEndBlockIterator{contract: _MetaNodeStake.contract, event: "SetEndBlock", logs: logs, sub: sub}, nil
+}
+
+// WatchSetEndBlock is a free log subscription operation binding the contract event 0x1132c5baccb51da3d049fabc819697dc845fa224ad59d9b555507d6446b40850.
+//
+// Solidity: event SetEndBlock(uint256 indexed endBlock)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchSetEndBlock(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeSetEndBlock, endBlock []*big.Int) (event.Subscription, error) {
+
+	var endBlockRule []interface{}
+	for _, endBlockItem := range endBlock {
+		endBlockRule = append(endBlockRule, endBlockItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "SetEndBlock", endBlockRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeSetEndBlock)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "SetEndBlock", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetEndBlock is a log parse operation binding the contract event 0x1132c5baccb51da3d049fabc819697dc845fa224ad59d9b555507d6446b40850.
+//
+// Solidity: event SetEndBlock(uint256 indexed endBlock)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseSetEndBlock(log types.Log) (*MetaNodeStakeSetEndBlock, error) {
+	event := new(MetaNodeStakeSetEndBlock)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "SetEndBlock", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeSetMetaNodeIterator is returned from FilterSetMetaNode and is used to iterate over the raw logs and unpacked data for SetMetaNode events raised by the MetaNodeStake contract.
+type MetaNodeStakeSetMetaNodeIterator struct {
+	Event *MetaNodeStakeSetMetaNode // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeSetMetaNodeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeSetMetaNode)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeSetMetaNode)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeSetMetaNodeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeSetMetaNodeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeSetMetaNode represents a SetMetaNode event raised by the MetaNodeStake contract.
+type MetaNodeStakeSetMetaNode struct {
+	MetaNode common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetMetaNode is a free log retrieval operation binding the contract event 0xafef10ff40345dd21fc1cac02d8ec620a709c9b0983e9c6454d2bef77f077df3.
+//
+// Solidity: event SetMetaNode(address indexed MetaNode)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterSetMetaNode(opts *bind.FilterOpts, MetaNode []common.Address) (*MetaNodeStakeSetMetaNodeIterator, error) {
+
+	var MetaNodeRule []interface{}
+	for _, MetaNodeItem := range MetaNode {
+		MetaNodeRule = append(MetaNodeRule, MetaNodeItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "SetMetaNode", MetaNodeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeSetMetaNodeIterator{contract: _MetaNodeStake.contract, event: "SetMetaNode", logs: logs, sub: sub}, nil
+}
+
+// WatchSetMetaNode is a free log subscription operation binding the contract event 0xafef10ff40345dd21fc1cac02d8ec620a709c9b0983e9c6454d2bef77f077df3.
+//
+// Solidity: event SetMetaNode(address indexed MetaNode)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchSetMetaNode(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeSetMetaNode, MetaNode []common.Address) (event.Subscription, error) {
+
+	var MetaNodeRule []interface{}
+	for _, MetaNodeItem := range MetaNode {
+		MetaNodeRule = append(MetaNodeRule, MetaNodeItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "SetMetaNode", MetaNodeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeSetMetaNode)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "SetMetaNode", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetMetaNode is a log parse operation binding the contract event 0xafef10ff40345dd21fc1cac02d8ec620a709c9b0983e9c6454d2bef77f077df3.
+//
+// Solidity: event SetMetaNode(address indexed MetaNode)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseSetMetaNode(log types.Log) (*MetaNodeStakeSetMetaNode, error) {
+	event := new(MetaNodeStakeSetMetaNode)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "SetMetaNode", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeSetMetaNodePerBlockIterator is returned from FilterSetMetaNodePerBlock and is used to iterate over the raw logs and unpacked data for SetMetaNodePerBlock events raised by the MetaNodeStake contract.
+type MetaNodeStakeSetMetaNodePerBlockIterator struct {
+	Event *MetaNodeStakeSetMetaNodePerBlock // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeSetMetaNodePerBlockIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeSetMetaNodePerBlock)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeSetMetaNodePerBlock)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeSetMetaNodePerBlockIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeSetMetaNodePerBlockIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeSetMetaNodePerBlock represents a SetMetaNodePerBlock event raised by the MetaNodeStake contract.
+type MetaNodeStakeSetMetaNodePerBlock struct {
+	MetaNodePerBlock *big.Int
+	Raw              types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetMetaNodePerBlock is a free log retrieval operation binding the contract event 0xa0b1720fac15ab5b6e184fa4d22f43e0e2594ae0373a61a3fc48aefb84ab92f7.
+//
+// Solidity: event SetMetaNodePerBlock(uint256 indexed MetaNodePerBlock)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterSetMetaNodePerBlock(opts *bind.FilterOpts, MetaNodePerBlock []*big.Int) (*MetaNodeStakeSetMetaNodePerBlockIterator, error) {
+
+	var MetaNodePerBlockRule []interface{}
+	for _, MetaNodePerBlockItem := range MetaNodePerBlock {
+		MetaNodePerBlockRule = append(MetaNodePerBlockRule, MetaNodePerBlockItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "SetMetaNodePerBlock", MetaNodePerBlockRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeSetMetaNodePerBlockIterator{contract: _MetaNodeStake.contract, event: "SetMetaNodePerBlock", logs: logs, sub: sub}, nil
+}
+
+// WatchSetMetaNodePerBlock is a free log subscription operation binding the contract event 0xa0b1720fac15ab5b6e184fa4d22f43e0e2594ae0373a61a3fc48aefb84ab92f7.
+//
+// Solidity: event SetMetaNodePerBlock(uint256 indexed MetaNodePerBlock)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchSetMetaNodePerBlock(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeSetMetaNodePerBlock, MetaNodePerBlock []*big.Int) (event.Subscription, error) {
+
+	var MetaNodePerBlockRule []interface{}
+	for _, MetaNodePerBlockItem := range MetaNodePerBlock {
+		MetaNodePerBlockRule = append(MetaNodePerBlockRule, MetaNodePerBlockItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "SetMetaNodePerBlock", MetaNodePerBlockRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeSetMetaNodePerBlock)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "SetMetaNodePerBlock", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetMetaNodePerBlock is a log parse operation binding the contract event 0xa0b1720fac15ab5b6e184fa4d22f43e0e2594ae0373a61a3fc48aefb84ab92f7.
+//
+// Solidity: event SetMetaNodePerBlock(uint256 indexed MetaNodePerBlock)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseSetMetaNodePerBlock(log types.Log) (*MetaNodeStakeSetMetaNodePerBlock, error) {
+	event := new(MetaNodeStakeSetMetaNodePerBlock)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "SetMetaNodePerBlock", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeSetPoolWeightIterator is returned from FilterSetPoolWeight and is used to iterate over the raw logs and unpacked data for SetPoolWeight events raised by the MetaNodeStake contract.
+type MetaNodeStakeSetPoolWeightIterator struct {
+	Event *MetaNodeStakeSetPoolWeight // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeSetPoolWeightIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeSetPoolWeight)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeSetPoolWeight)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeSetPoolWeightIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeSetPoolWeightIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeSetPoolWeight represents a SetPoolWeight event raised by the MetaNodeStake contract.
+type MetaNodeStakeSetPoolWeight struct {
+	PoolId          *big.Int
+	PoolWeight      *big.Int
+	TotalPoolWeight *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetPoolWeight is a free log retrieval operation binding the contract event 0x4b8fa3d6a87cb21d1bf4978bf60628ae358a28ac7f39de1751a481c6dd957617.
+//
+// Solidity: event SetPoolWeight(uint256 indexed poolId, uint256 indexed poolWeight, uint256 totalPoolWeight)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterSetPoolWeight(opts *bind.FilterOpts, poolId []*big.Int, poolWeight []*big.Int) (*MetaNodeStakeSetPoolWeightIterator, error) {
+
+	var poolIdRule []interface{}
+	for _, poolIdItem := range poolId {
+		poolIdRule = append(poolIdRule, poolIdItem)
+	}
+	var poolWeightRule []interface{}
+	for _, poolWeightItem := range poolWeight {
+		poolWeightRule = append(poolWeightRule, poolWeightItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "SetPoolWeight", poolIdRule, poolWeightRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeSetPoolWeightIterator{contract: _MetaNodeStake.contract, event: "SetPoolWeight", logs: logs, sub: sub}, nil
+}
+
+// WatchSetPoolWeight is a free log subscription operation binding the contract event 0x4b8fa3d6a87cb21d1bf4978bf60628ae358a28ac7f39de1751a481c6dd957617.
+//
+// Solidity: event SetPoolWeight(uint256 indexed poolId, uint256 indexed poolWeight, uint256 totalPoolWeight)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchSetPoolWeight(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeSetPoolWeight, poolId []*big.Int, poolWeight []*big.Int) (event.Subscription, error) {
+
+	var poolIdRule []interface{}
+	for _, poolIdItem := range poolId {
+		poolIdRule = append(poolIdRule, poolIdItem)
+	}
+	var poolWeightRule []interface{}
+	for _, poolWeightItem := range poolWeight {
+		poolWeightRule = append(poolWeightRule, poolWeightItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "SetPoolWeight", poolIdRule, poolWeightRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeSetPoolWeight)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "SetPoolWeight", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetPoolWeight is a log parse operation binding the contract event 0x4b8fa3d6a87cb21d1bf4978bf60628ae358a28ac7f39de1751a481c6dd957617.
+//
+// Solidity: event SetPoolWeight(uint256 indexed poolId, uint256 indexed poolWeight, uint256 totalPoolWeight)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseSetPoolWeight(log types.Log) (*MetaNodeStakeSetPoolWeight, error) {
+	event := new(MetaNodeStakeSetPoolWeight)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "SetPoolWeight", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeSetStartBlockIterator is returned from FilterSetStartBlock and is used to iterate over the raw logs and unpacked data for SetStartBlock events raised by the MetaNodeStake contract.
+type MetaNodeStakeSetStartBlockIterator struct {
+	Event *MetaNodeStakeSetStartBlock // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeSetStartBlockIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeSetStartBlock)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeSetStartBlock)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeSetStartBlockIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeSetStartBlockIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeSetStartBlock represents a SetStartBlock event raised by the MetaNodeStake contract.
+type MetaNodeStakeSetStartBlock struct {
+	StartBlock *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetStartBlock is a free log retrieval operation binding the contract event 0x63b90b79f11a0f132bcb2c4a4ddd44abda45c1308a83b2919318df7f5f8b7be4.
+//
+// Solidity: event SetStartBlock(uint256 indexed startBlock)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterSetStartBlock(opts *bind.FilterOpts, startBlock []*big.Int) (*MetaNodeStakeSetStartBlockIterator, error) {
+
+	var startBlockRule []interface{}
+	for _, startBlockItem := range startBlock {
+		startBlockRule = append(startBlockRule, startBlockItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "SetStartBlock", startBlockRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeSetStartBlockIterator{contract: _MetaNodeStake.contract, event: "SetStartBlock", logs: logs, sub: sub}, nil
+}
+
+// WatchSetStartBlock is a free log subscription operation binding the contract event 0x63b90b79f11a0f132bcb2c4a4ddd44abda45c1308a83b2919318df7f5f8b7be4.
+//
+// Solidity: event SetStartBlock(uint256 indexed startBlock)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchSetStartBlock(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeSetStartBlock, startBlock []*big.Int) (event.Subscription, error) {
+
+	var startBlockRule []interface{}
+	for _, startBlockItem := range startBlock {
+		startBlockRule = append(startBlockRule, startBlockItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "SetStartBlock", startBlockRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeSetStartBlock)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "SetStartBlock", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetStartBlock is a log parse operation binding the contract event 0x63b90b79f11a0f132bcb2c4a4ddd44abda45c1308a83b2919318df7f5f8b7be4.
+//
+// Solidity: event SetStartBlock(uint256 indexed startBlock)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseSetStartBlock(log types.Log) (*MetaNodeStakeSetStartBlock, error) {
+	event := new(MetaNodeStakeSetStartBlock)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "SetStartBlock", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeUnpauseClaimIterator is returned from FilterUnpauseClaim and is used to iterate over the raw logs and unpacked data for UnpauseClaim events raised by the MetaNodeStake contract.
+type MetaNodeStakeUnpauseClaimIterator struct {
+	Event *MetaNodeStakeUnpauseClaim // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeUnpauseClaimIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeUnpauseClaim)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeUnpauseClaim)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeUnpauseClaimIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeUnpauseClaimIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeUnpauseClaim represents a UnpauseClaim event raised by the MetaNodeStake contract.
+type MetaNodeStakeUnpauseClaim struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpauseClaim is a free log retrieval operation binding the contract event 0xe72cb12952f056e3e7496019725f20a13108ca420f67f1ee9c9cdab73fb8ce85.
+//
+// Solidity: event UnpauseClaim()
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterUnpauseClaim(opts *bind.FilterOpts) (*MetaNodeStakeUnpauseClaimIterator, error) {
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "UnpauseClaim")
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeUnpauseClaimIterator{contract: _MetaNodeStake.contract, event: "UnpauseClaim", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpauseClaim is a free log subscription operation binding the contract event 0xe72cb12952f056e3e7496019725f20a13108ca420f67f1ee9c9cdab73fb8ce85.
+//
+// Solidity: event UnpauseClaim()
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchUnpauseClaim(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeUnpauseClaim) (event.Subscription, error) {
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "UnpauseClaim")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeUnpauseClaim)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "UnpauseClaim", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpauseClaim is a log parse operation binding the contract event 0xe72cb12952f056e3e7496019725f20a13108ca420f67f1ee9c9cdab73fb8ce85.
+//
+// Solidity: event UnpauseClaim()
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseUnpauseClaim(log types.Log) (*MetaNodeStakeUnpauseClaim, error) {
+	event := new(MetaNodeStakeUnpauseClaim)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "UnpauseClaim", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeUnpauseWithdrawIterator is returned from FilterUnpauseWithdraw and is used to iterate over the raw logs and unpacked data for UnpauseWithdraw events raised by the MetaNodeStake contract.
+type MetaNodeStakeUnpauseWithdrawIterator struct {
+	Event *MetaNodeStakeUnpauseWithdraw // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeUnpauseWithdrawIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeUnpauseWithdraw)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeUnpauseWithdraw)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeUnpauseWithdrawIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeUnpauseWithdrawIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeUnpauseWithdraw represents a UnpauseWithdraw event raised by the MetaNodeStake contract.
+type MetaNodeStakeUnpauseWithdraw struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpauseWithdraw is a free log retrieval operation binding the contract event 0x1c84bcaead48b692cc46b9b12e9a068951a59c99a2e2bf10b00b60b403cf12e2.
+//
+// Solidity: event UnpauseWithdraw()
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterUnpauseWithdraw(opts *bind.FilterOpts) (*MetaNodeStakeUnpauseWithdrawIterator, error) {
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "UnpauseWithdraw")
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeUnpauseWithdrawIterator{contract: _MetaNodeStake.contract, event: "UnpauseWithdraw", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpauseWithdraw is a free log subscription operation binding the contract event 0x1c84bcaead48b692cc46b9b12e9a068951a59c99a2e2bf10b00b60b403cf12e2.
+//
+// Solidity: event UnpauseWithdraw()
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchUnpauseWithdraw(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeUnpauseWithdraw) (event.Subscription, error) {
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "UnpauseWithdraw")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeUnpauseWithdraw)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "UnpauseWithdraw", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpauseWithdraw is a log parse operation binding the contract event 0x1c84bcaead48b692cc46b9b12e9a068951a59c99a2e2bf10b00b60b403cf12e2.
+//
+// Solidity: event UnpauseWithdraw()
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseUnpauseWithdraw(log types.Log) (*MetaNodeStakeUnpauseWithdraw, error) {
+	event := new(MetaNodeStakeUnpauseWithdraw)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "UnpauseWithdraw", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeUnpausedIterator is returned from FilterUnpaused and is used to iterate over the raw logs and unpacked data for Unpaused events raised by the MetaNodeStake contract.
+type MetaNodeStakeUnpausedIterator struct {
+	Event *MetaNodeStakeUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeUnpaused represents a Unpaused event raised by the MetaNodeStake contract.
+type MetaNodeStakeUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnpaused is a free log retrieval operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterUnpaused(opts *bind.FilterOpts) (*MetaNodeStakeUnpausedIterator, error) {
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeUnpausedIterator{contract: _MetaNodeStake.contract, event: "Unpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchUnpaused is a free log subscription operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchUnpaused(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeUnpaused) (event.Subscription, error) {
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "Unpaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeUnpaused)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "Unpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnpaused is a log parse operation binding the contract event 0x5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa.
+//
+// Solidity: event Unpaused(address account)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseUnpaused(log types.Log) (*MetaNodeStakeUnpaused, error) {
+	event := new(MetaNodeStakeUnpaused)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "Unpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeUpdatePoolIterator is returned from FilterUpdatePool and is used to iterate over the raw logs and unpacked data for UpdatePool events raised by the MetaNodeStake contract.
+type MetaNodeStakeUpdatePoolIterator struct {
+	Event *MetaNodeStakeUpdatePool // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeUpdatePoolIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeUpdatePool)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeUpdatePool)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeUpdatePoolIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeUpdatePoolIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeUpdatePool represents a UpdatePool event raised by the MetaNodeStake contract.
+type MetaNodeStakeUpdatePool struct {
+	PoolId          *big.Int
+	LastRewardBlock *big.Int
+	TotalMetaNode   *big.Int
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpdatePool is a free log retrieval operation binding the contract event 0xf5d2d72d9b25d6853afd7d0554a113b705234b6a68bb36b7f143662994632411.
+//
+// Solidity: event UpdatePool(uint256 indexed poolId, uint256 indexed lastRewardBlock, uint256 totalMetaNode)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterUpdatePool(opts *bind.FilterOpts, poolId []*big.Int, lastRewardBlock []*big.Int) (*MetaNodeStakeUpdatePoolIterator, error) {
+
+	var poolIdRule []interface{}
+	for _, poolIdItem := range poolId {
+		poolIdRule = append(poolIdRule, poolIdItem)
+	}
+	var lastRewardBlockRule []interface{}
+	for _, lastRewardBlockItem := range lastRewardBlock {
+		lastRewardBlockRule = append(lastRewardBlockRule, lastRewardBlockItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "UpdatePool", poolIdRule, lastRewardBlockRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeUpdatePoolIterator{contract: _MetaNodeStake.contract, event: "UpdatePool", logs: logs, sub: sub}, nil
+}
+
+// WatchUpdatePool is a free log subscription operation binding the contract event 0xf5d2d72d9b25d6853afd7d0554a113b705234b6a68bb36b7f143662994632411.
+//
+// Solidity: event UpdatePool(uint256 indexed poolId, uint256 indexed lastRewardBlock, uint256 totalMetaNode)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchUpdatePool(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeUpdatePool, poolId []*big.Int, lastRewardBlock []*big.Int) (event.Subscription, error) {
+
+	var poolIdRule []interface{}
+	for _, poolIdItem := range poolId {
+		poolIdRule = append(poolIdRule, poolIdItem)
+	}
+	var lastRewardBlockRule []interface{}
+	for _, lastRewardBlockItem := range lastRewardBlock {
+		lastRewardBlockRule = append(lastRewardBlockRule, lastRewardBlockItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "UpdatePool", poolIdRule, lastRewardBlockRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeUpdatePool)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "UpdatePool", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpdatePool is a log parse operation binding the contract event 0xf5d2d72d9b25d6853afd7d0554a113b705234b6a68bb36b7f143662994632411.
+//
+// Solidity: event UpdatePool(uint256 indexed poolId, uint256 indexed lastRewardBlock, uint256 totalMetaNode)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseUpdatePool(log types.Log) (*MetaNodeStakeUpdatePool, error) {
+	event := new(MetaNodeStakeUpdatePool)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "UpdatePool", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeUpdatePoolInfoIterator is returned from FilterUpdatePoolInfo and is used to iterate over the raw logs and unpacked data for UpdatePoolInfo events raised by the MetaNodeStake contract.
+type MetaNodeStakeUpdatePoolInfoIterator struct {
+	Event *MetaNodeStakeUpdatePoolInfo // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeUpdatePoolInfoIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeUpdatePoolInfo)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeUpdatePoolInfo)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeUpdatePoolInfoIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeUpdatePoolInfoIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeUpdatePoolInfo represents a UpdatePoolInfo event raised by the MetaNodeStake contract.
+type MetaNodeStakeUpdatePoolInfo struct {
+	PoolId              *big.Int
+	MinDepositAmount    *big.Int
+	UnstakeLockedBlocks *big.Int
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpdatePoolInfo is a free log retrieval operation binding the contract event 0x30dffdedaa3e3b4849298233f7cd71d229956e875ab09270498c96b7cf9181fd.
+//
+// Solidity: event UpdatePoolInfo(uint256 indexed poolId, uint256 indexed minDepositAmount, uint256 indexed unstakeLockedBlocks)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterUpdatePoolInfo(opts *bind.FilterOpts, poolId []*big.Int, minDepositAmount []*big.Int, unstakeLockedBlocks []*big.Int) (*MetaNodeStakeUpdatePoolInfoIterator, error) {
+
+	var poolIdRule []interface{}
+	for _, poolIdItem := range poolId {
+		poolIdRule = append(poolIdRule, poolIdItem)
+	}
+	var minDepositAmountRule []interface{}
+	for _, minDepositAmountItem := range minDepositAmount {
+		minDepositAmountRule = append(minDepositAmountRule, minDepositAmountItem)
+	}
+	var unstakeLockedBlocksRule []interface{}
+	for _, unstakeLockedBlocksItem := range unstakeLockedBlocks {
+		unstakeLockedBlocksRule = append(unstakeLockedBlocksRule, unstakeLockedBlocksItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "UpdatePoolInfo", poolIdRule, minDepositAmountRule, unstakeLockedBlocksRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeUpdatePoolInfoIterator{contract: _MetaNodeStake.contract, event: "UpdatePoolInfo", logs: logs, sub: sub}, nil
+}
+
+// WatchUpdatePoolInfo is a free log subscription operation binding the contract event 0x30dffdedaa3e3b4849298233f7cd71d229956e875ab09270498c96b7cf9181fd.
+//
+// Solidity: event UpdatePoolInfo(uint256 indexed poolId, uint256 indexed minDepositAmount, uint256 indexed unstakeLockedBlocks)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchUpdatePoolInfo(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeUpdatePoolInfo, poolId []*big.Int, minDepositAmount []*big.Int, unstakeLockedBlocks []*big.Int) (event.Subscription, error) {
+
+	var poolIdRule []interface{}
+	for _, poolIdItem := range poolId {
+		poolIdRule = append(poolIdRule, poolIdItem)
+	}
+	var minDepositAmountRule []interface{}
+	for _, minDepositAmountItem := range minDepositAmount {
+		minDepositAmountRule = append(minDepositAmountRule, minDepositAmountItem)
+	}
+	var unstakeLockedBlocksRule []interface{}
+	for _, unstakeLockedBlocksItem := range unstakeLockedBlocks {
+		unstakeLockedBlocksRule = append(unstakeLockedBlocksRule, unstakeLockedBlocksItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "UpdatePoolInfo", poolIdRule, minDepositAmountRule, unstakeLockedBlocksRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeUpdatePoolInfo)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "UpdatePoolInfo", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpdatePoolInfo is a log parse operation binding the contract event 0x30dffdedaa3e3b4849298233f7cd71d229956e875ab09270498c96b7cf9181fd.
+//
+// Solidity: event UpdatePoolInfo(uint256 indexed poolId, uint256 indexed minDepositAmount, uint256 indexed unstakeLockedBlocks)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseUpdatePoolInfo(log types.Log) (*MetaNodeStakeUpdatePoolInfo, error) {
+	event := new(MetaNodeStakeUpdatePoolInfo)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "UpdatePoolInfo", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the MetaNodeStake contract.
+type MetaNodeStakeUpgradedIterator struct {
+	Event *MetaNodeStakeUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeUpgraded represents a Upgraded event raised by the MetaNodeStake contract.
+type MetaNodeStakeUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*MetaNodeStakeUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeUpgradedIterator{contract: _MetaNodeStake.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeUpgraded)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseUpgraded(log types.Log) (*MetaNodeStakeUpgraded, error) {
+	event := new(MetaNodeStakeUpgraded)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MetaNodeStakeWithdrawIterator is returned from FilterWithdraw and is used to iterate over the raw logs and unpacked data for Withdraw events raised by the MetaNodeStake contract.
+type MetaNodeStakeWithdrawIterator struct {
+	Event *MetaNodeStakeWithdraw // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MetaNodeStakeWithdrawIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MetaNodeStakeWithdraw)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MetaNodeStakeWithdraw)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MetaNodeStakeWithdrawIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MetaNodeStakeWithdrawIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MetaNodeStakeWithdraw represents a Withdraw event raised by the MetaNodeStake contract.
+type MetaNodeStakeWithdraw struct {
+	User        common.Address
+	PoolId      *big.Int
+	Amount      *big.Int
+	BlockNumber *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdraw is a free log retrieval operation binding the contract event 0x02f25270a4d87bea75db541cdfe559334a275b4a233520ed6c0a2429667cca94.
+//
+// Solidity: event Withdraw(address indexed user, uint256 indexed poolId, uint256 amount, uint256 indexed blockNumber)
+func (_MetaNodeStake *MetaNodeStakeFilterer) FilterWithdraw(opts *bind.FilterOpts, user []common.Address, poolId []*big.Int, blockNumber []*big.Int) (*MetaNodeStakeWithdrawIterator, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var poolIdRule []interface{}
+	for _, poolIdItem := range poolId {
+		poolIdRule = append(poolIdRule, poolIdItem)
+	}
+
+	var blockNumberRule []interface{}
+	for _, blockNumberItem := range blockNumber {
+		blockNumberRule = append(blockNumberRule, blockNumberItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.FilterLogs(opts, "Withdraw", userRule, poolIdRule, blockNumberRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MetaNodeStakeWithdrawIterator{contract: _MetaNodeStake.contract, event: "Withdraw", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdraw is a free log subscription operation binding the contract event 0x02f25270a4d87bea75db541cdfe559334a275b4a233520ed6c0a2429667cca94.
+//
+// Solidity: event Withdraw(address indexed user, uint256 indexed poolId, uint256 amount, uint256 indexed blockNumber)
+func (_MetaNodeStake *MetaNodeStakeFilterer) WatchWithdraw(opts *bind.WatchOpts, sink chan<- *MetaNodeStakeWithdraw, user []common.Address, poolId []*big.Int, blockNumber []*big.Int) (event.Subscription, error) {
+
+	var userRule []interface{}
+	for _, userItem := range user {
+		userRule = append(userRule, userItem)
+	}
+	var poolIdRule []interface{}
+	for _, poolIdItem := range poolId {
+		poolIdRule = append(poolIdRule, poolIdItem)
+	}
+
+	var blockNumberRule []interface{}
+	for _, blockNumberItem := range blockNumber {
+		blockNumberRule = append(blockNumberRule, blockNumberItem)
+	}
+
+	logs, sub, err := _MetaNodeStake.contract.WatchLogs(opts, "Withdraw", userRule, poolIdRule, blockNumberRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MetaNodeStakeWithdraw)
+				if err := _MetaNodeStake.contract.UnpackLog(event, "Withdraw", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdraw is a log parse operation binding the contract event 0x02f25270a4d87bea75db541cdfe559334a275b4a233520ed6c0a2429667cca94.
+//
+// Solidity: event Withdraw(address indexed user, uint256 indexed poolId, uint256 amount, uint256 indexed blockNumber)
+func (_MetaNodeStake *MetaNodeStakeFilterer) ParseWithdraw(log types.Log) (*MetaNodeStakeWithdraw, error) {
+	event := new(MetaNodeStakeWithdraw)
+	if err := _MetaNodeStake.contract.UnpackLog(event, "Withdraw", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
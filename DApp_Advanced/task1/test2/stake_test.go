@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"counter/internal/staketest"
+	"ethtx"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// stakeChain 在质押模拟节点上补充CLI使用的节点接口，未实现的方法不会被调用
+type stakeChain struct {
+	counterBackend
+	*staketest.Chain
+}
+
+func (c stakeChain) BlockNumber(ctx context.Context) (uint64, error) {
+	return c.Chain.BlockNumber(ctx)
+}
+
+func (c stakeChain) CodeAt(ctx context.Context, account common.Address, block *big.Int) ([]byte, error) {
+	return c.Chain.CodeAt(ctx, account, block)
+}
+
+func (c stakeChain) CallContract(ctx context.Context, call ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	return c.Chain.CallContract(ctx, call, block)
+}
+
+func TestStakeCommandsCheckBeforeSending(t *testing.T) {
+	chain := stakeChain{Chain: staketest.NewChain()}
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := defaultConfig()
+	cfg.Contracts.MetaNodeStake = staketest.StakeAddress.Hex()
+	out := &bytes.Buffer{}
+	app := &cli{cfg: cfg, backend: chain, chainID: simChainID, json: true, out: out}
+	app.sender = ethtx.NewSender(chain, ethtx.NewKeySigner(key), simChainID)
+
+	if err := runCommand(t, app, "positions", "-account", staketest.USDTAddress.Hex()); err != nil {
+		t.Fatal(err)
+	}
+	var report stakeReportOutput
+	if err := json.Unmarshal(out.Bytes(), &report); err != nil || report.Account != staketest.USDTAddress.Hex() || len(report.Pools) != 3 {
+		t.Errorf("positions output %q", out.String())
+	}
+	// 数量按各自代币的精度格式化，奖励按MetaNode的精度
+	if report.Block != 1000 || report.RewardSymbol != "MetaNode" || report.TotalPendingReward != "1.5" {
+		t.Errorf("positions report = %+v", report)
+	}
+	if got := report.Pools[0]; got.Symbol != "ETH" || got.Staked != "2" || got.Unstaking != "3" || got.Unlockable != "1" || got.Locked != "2" || got.UnstakeLockedBlocks != 20 {
+		t.Errorf("ETH pool = %+v", got)
+	}
+	if got := report.Pools[1]; got.Symbol != "USDT" || got.Staked != "1.5" || got.PendingReward != "0.5" || got.Locked != "0" {
+		t.Errorf("USDT pool = %+v", got)
+	}
+
+	// 合约在没有可提取数量或奖励时不会回滚，命令在发送交易之前报告
+	if err := runCommand(t, app, "withdraw", "-pool", "1"); err == nil || !strings.Contains(err.Error(), "no unstake requests") {
+		t.Errorf("withdraw without requests: err = %v", err)
+	}
+	chain.Pools[1].Requested = big.NewInt(700_000)
+	if err := runCommand(t, app, "withdraw", "-pool", "1"); err == nil || !strings.Contains(err.Error(), "0.7 USDT is still locked") {
+		t.Errorf("withdraw while locked: err = %v", err)
+	}
+	if err := runCommand(t, app, "claim", "-pool", "2"); err == nil || !strings.Contains(err.Error(), "no MetaNode reward") {
+		t.Errorf("claim without reward: err = %v", err)
+	}
+	if err := runCommand(t, app, "stake", "-pool", "5", "1"); err == nil || !strings.Contains(err.Error(), "pool 5 does not exist") {
+		t.Errorf("stake to unknown pool: err = %v", err)
+	}
+}
@@ -0,0 +1,347 @@
+// Package stakeclient 是 MetaNodeStake 质押合约的客户端，基于 counter/stake 中的绑定
+package stakeclient
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	Stake "counter/stake" // 别名导入，使用首字母大写的包名
+	"ethtx"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Backend Client 使用的节点接口，查询持仓时用 BlockNumber 固定查询的区块，BalanceAt 查询ETH余额
+type Backend interface {
+	bind.ContractBackend
+	BlockNumber(ctx context.Context) (uint64, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// Pool MetaNodeStake 的一个质押池
+type Pool struct {
+	ID                  uint64
+	Token               common.Address // 质押代币地址，ETH质押池为零地址
+	Symbol              string         // 代币合约没有 symbol() 时为空
+	Decimals            uint8
+	Weight              *big.Int
+	TotalStaked         *big.Int
+	MinDeposit          *big.Int
+	UnstakeLockedBlocks uint64 // 解除质押后需要等待的区块数
+
+	token *ethtx.Token // ETH质押池为 nil
+}
+
+// IsETH 是否为ETH质押池（pool 0，通过 depositETH 质押）
+func (p *Pool) IsETH() bool {
+	return p.Token == (common.Address{})
+}
+
+// ParseAmount 按质押代币的精度解析数量，例如 1.5
+func (p *Pool) ParseAmount(s string) (*big.Int, error) {
+	return ethtx.ParseUnits(s, p.Decimals)
+}
+
+// FormatAmount 按质押代币的精度格式化数量
+func (p *Pool) FormatAmount(amount *big.Int) string {
+	return ethtx.FormatUnits(amount, p.Decimals)
+}
+
+// String 返回质押池的描述，例如 "pool 1 (USDT)"
+func (p *Pool) String() string {
+	if p.Symbol == "" {
+		return fmt.Sprintf("pool %d (%s)", p.ID, p.Token.Hex())
+	}
+	return fmt.Sprintf("pool %d (%s)", p.ID, p.Symbol)
+}
+
+// Position 账户在一个质押池中的持仓
+type Position struct {
+	Pool          *Pool
+	Staked        *big.Int // 当前质押数量（stakingBalance）
+	PendingReward *big.Int // 待领取的MetaNode奖励（pendingMetaNode）
+	Requested     *big.Int // 所有未提取的解除质押请求（withdrawAmount 的 requestAmount）
+	Unlockable    *big.Int // 其中已过锁定期、可以 withdraw 的数量（withdrawAmount 的 pendingWithdrawAmount）
+}
+
+// Locked 解除质押请求中仍在锁定期的数量
+func (p *Position) Locked() *big.Int {
+	return new(big.Int).Sub(p.Requested, p.Unlockable)
+}
+
+// Empty 账户在该质押池中没有质押、奖励和解除质押请求
+func (p *Position) Empty() bool {
+	return p.Staked.Sign() == 0 && p.PendingReward.Sign() == 0 && p.Requested.Sign() == 0
+}
+
+// Report 账户在所有质押池中的持仓，所有数据读取自同一个区块
+type Report struct {
+	Account            common.Address
+	Block              uint64
+	RewardToken        *ethtx.Token // MetaNode奖励代币
+	Positions          []Position
+	TotalPendingReward *big.Int
+}
+
+// Call 一笔待发送的质押合约（或质押代币授权）交易
+// 调用方用 Data 和 Value 估算gas、设置手续费后，通过 ethtx.Sender.Transact 调用 Send 发送
+type Call struct {
+	Method string
+	To     common.Address
+	Value  *big.Int // 只有 depositETH 附带ETH
+	Data   []byte
+	Send   func(opts *bind.TransactOpts) (*types.Transaction, error)
+}
+
+// Client MetaNodeStake 质押合约的客户端：查询质押池和账户持仓，构造质押、解除质押、提取和领取奖励交易
+type Client struct {
+	Address common.Address
+
+	backend  Backend
+	contract *Stake.MetaNodeStake
+}
+
+// New 创建 address 上 MetaNodeStake 合约（代理合约地址）的客户端
+func New(address common.Address, backend Backend) (*Client, error) {
+	contract, err := Stake.NewMetaNodeStake(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{Address: address, backend: backend, contract: contract}, nil
+}
+
+// RewardToken 返回MetaNode奖励代币
+func (s *Client) RewardToken(ctx context.Context) (*ethtx.Token, error) {
+	address, err := s.contract.MetaNode(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("call MetaNode: %w", err)
+	}
+	return ethtx.NewToken(ctx, s.backend, address)
+}
+
+// Pool 返回质押池 id，不存在时返回错误而不是合约的 "invalid pid" 回滚
+func (s *Client) Pool(ctx context.Context, id uint64) (*Pool, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	length, err := s.contract.PoolLength(opts)
+	if err != nil {
+		return nil, fmt.Errorf("call poolLength: %w", err)
+	}
+	if new(big.Int).SetUint64(id).Cmp(length) >= 0 {
+		return nil, fmt.Errorf("pool %d does not exist (%s pools)", id, length)
+	}
+	return s.pool(ctx, opts, id)
+}
+
+// Pools 返回所有质押池
+func (s *Client) Pools(ctx context.Context) ([]*Pool, error) {
+	return s.pools(ctx, &bind.CallOpts{Context: ctx})
+}
+
+func (s *Client) pools(ctx context.Context, opts *bind.CallOpts) ([]*Pool, error) {
+	length, err := s.contract.PoolLength(opts)
+	if err != nil {
+		return nil, fmt.Errorf("call poolLength: %w", err)
+	}
+	pools := make([]*Pool, 0, length.Uint64())
+	for id := uint64(0); id < length.Uint64(); id++ {
+		pool, err := s.pool(ctx, opts, id)
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, pool)
+	}
+	return pools, nil
+}
+
+// pool 读取质押池信息，代币池同时读取代币的精度和符号
+func (s *Client) pool(ctx context.Context, opts *bind.CallOpts, id uint64) (*Pool, error) {
+	info, err := s.contract.Pool(opts, new(big.Int).SetUint64(id))
+	if err != nil {
+		return nil, fmt.Errorf("call pool(%d): %w", id, err)
+	}
+	pool := &Pool{
+		ID:                  id,
+		Token:               info.StTokenAddress,
+		Symbol:              "ETH",
+		Decimals:            ethtx.EtherDecimals,
+		Weight:              info.PoolWeight,
+		TotalStaked:         info.StTokenAmount,
+		MinDeposit:          info.MinDepositAmount,
+		UnstakeLockedBlocks: info.UnstakeLockedBlocks.Uint64(),
+	}
+	if !pool.IsETH() {
+		if pool.token, err = ethtx.NewToken(ctx, s.backend, pool.Token); err != nil {
+			return nil, fmt.Errorf("pool %d: %w", id, err)
+		}
+		pool.Symbol, pool.Decimals = pool.token.Symbol, pool.token.Decimals
+	}
+	return pool, nil
+}
+
+// Position 返回账户在质押池中的最新持仓
+func (s *Client) Position(ctx context.Context, pool *Pool, account common.Address) (*Position, error) {
+	return s.position(&bind.CallOpts{Context: ctx}, pool, account)
+}
+
+func (s *Client) position(opts *bind.CallOpts, pool *Pool, account common.Address) (*Position, error) {
+	pid := new(big.Int).SetUint64(pool.ID)
+	staked, err := s.contract.StakingBalance(opts, pid, account)
+	if err != nil {
+		return nil, fmt.Errorf("call stakingBalance(%d): %w", pool.ID, err)
+	}
+	pending, err := s.contract.PendingMetaNode(opts, pid, account)
+	if err != nil {
+		return nil, fmt.Errorf("call pendingMetaNode(%d): %w", pool.ID, err)
+	}
+	withdrawals, err := s.contract.WithdrawAmount(opts, pid, account)
+	if err != nil {
+		return nil, fmt.Errorf("call withdrawAmount(%d): %w", pool.ID, err)
+	}
+	return &Position{
+		Pool:          pool,
+		Staked:        staked,
+		PendingReward: pending,
+		Requested:     withdrawals.RequestAmount,
+		Unlockable:    withdrawals.PendingWithdrawAmount,
+	}, nil
+}
+
+// Report 返回账户在所有质押池中的持仓、待领取奖励和可提取的解除质押数量
+// pendingMetaNode 和 withdrawAmount 的结果取决于区块号，所有查询都固定在同一个最新区块上
+func (s *Client) Report(ctx context.Context, account common.Address) (*Report, error) {
+	block, err := s.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get block number: %w", err)
+	}
+	rewardToken, err := s.RewardToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(block)}
+	pools, err := s.pools(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Account: account, Block: block, RewardToken: rewardToken, TotalPendingReward: new(big.Int)}
+	for _, pool := range pools {
+		position, err := s.position(opts, pool, account)
+		if err != nil {
+			return nil, err
+		}
+		report.Positions = append(report.Positions, *position)
+		report.TotalPendingReward.Add(report.TotalPendingReward, position.PendingReward)
+	}
+	return report, nil
+}
+
+// Deposit 返回质押 amount 的交易：ETH质押池调用 depositETH 并附带ETH，代币池调用 deposit（需要先授权，见 Approve）
+func (s *Client) Deposit(pool *Pool, amount *big.Int) (Call, error) {
+	if pool.IsETH() {
+		return s.call("depositETH", amount, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			opts.Value = amount
+			return s.contract.DepositETH(opts)
+		})
+	}
+	pid := new(big.Int).SetUint64(pool.ID)
+	return s.call("deposit", nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return s.contract.Deposit(opts, pid, amount)
+	}, pid, amount)
+}
+
+// Unstake 返回申请解除质押 amount 的交易，锁定 UnstakeLockedBlocks 个区块后才能 Withdraw
+func (s *Client) Unstake(pool *Pool, amount *big.Int) (Call, error) {
+	pid := new(big.Int).SetUint64(pool.ID)
+	return s.call("unstake", nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return s.contract.Unstake(opts, pid, amount)
+	}, pid, amount)
+}
+
+// Withdraw 返回提取所有已解锁的解除质押请求的交易
+func (s *Client) Withdraw(pool *Pool) (Call, error) {
+	pid := new(big.Int).SetUint64(pool.ID)
+	return s.call("withdraw", nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return s.contract.Withdraw(opts, pid)
+	}, pid)
+}
+
+// Claim 返回领取质押池中所有待领取MetaNode奖励的交易
+func (s *Client) Claim(pool *Pool) (Call, error) {
+	pid := new(big.Int).SetUint64(pool.ID)
+	return s.call("claim", nil, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return s.contract.Claim(opts, pid)
+	}, pid)
+}
+
+// call 编码质押合约 method 的调用数据
+func (s *Client) call(method string, value *big.Int, send func(*bind.TransactOpts) (*types.Transaction, error), args ...interface{}) (Call, error) {
+	parsed, err := Stake.MetaNodeStakeMetaData.GetAbi()
+	if err != nil {
+		return Call{}, err
+	}
+	data, err := parsed.Pack(method, args...)
+	if err != nil {
+		return Call{}, fmt.Errorf("pack %s: %w", method, err)
+	}
+	return Call{Method: method, To: s.Address, Value: value, Data: data, Send: send}, nil
+}
+
+// Allowance 返回账户授权质押合约使用的代币池代币数量
+func (s *Client) Allowance(ctx context.Context, pool *Pool, owner common.Address) (*big.Int, error) {
+	return pool.token.Allowance(ctx, owner, s.Address)
+}
+
+// Balance 返回账户持有的质押代币数量，ETH质押池返回ETH余额
+func (s *Client) Balance(ctx context.Context, pool *Pool, account common.Address) (*big.Int, error) {
+	if pool.IsETH() {
+		return s.backend.BalanceAt(ctx, account, nil)
+	}
+	return pool.token.BalanceOf(ctx, account)
+}
+
+// Approve 返回授权质押合约使用 amount 个代币池代币的交易，deposit 通过 transferFrom 转入代币
+func (s *Client) Approve(pool *Pool, amount *big.Int) (Call, error) {
+	data, err := pool.token.PackApprove(s.Address, amount)
+	if err != nil {
+		return Call{}, err
+	}
+	token := bind.NewBoundContract(pool.Token, ethtx.ERC20ABI, s.backend, s.backend, s.backend)
+	return Call{
+		Method: "approve",
+		To:     pool.Token,
+		Data:   data,
+		Send: func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return token.Transact(opts, "approve", s.Address, amount)
+		},
+	}, nil
+}
+
+// Withdrawn 返回回执中 Withdraw 事件提取的数量，没有该事件时返回 nil
+func (s *Client) Withdrawn(receipt *types.Receipt) *big.Int {
+	for _, log := range receipt.Logs {
+		if log.Address != s.Address {
+			continue
+		}
+		if event, err := s.contract.ParseWithdraw(*log); err == nil {
+			return event.Amount
+		}
+	}
+	return nil
+}
+
+// Claimed 返回回执中 Claim 事件的奖励数量，没有该事件时返回 nil
+// 合约中的MetaNode不足时实际转账数量会少于事件中的数量，以奖励代币余额为准
+func (s *Client) Claimed(receipt *types.Receipt) *big.Int {
+	for _, log := range receipt.Logs {
+		if log.Address != s.Address {
+			continue
+		}
+		if event, err := s.contract.ParseClaim(*log); err == nil {
+			return event.MetaNodeReward
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package stakeclient
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"counter/internal/staketest"
+	Stake "counter/stake"
+	"ethtx"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestClientReport(t *testing.T) {
+	chain := staketest.NewChain()
+	client, err := New(staketest.StakeAddress, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	account := common.HexToAddress("0x00000000000000000000000000000000000000b1")
+	report, err := client.Report(context.Background(), account)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// pendingMetaNode 和 withdrawAmount 依赖区块号，所有读取必须固定在同一个区块
+	for _, block := range chain.CallBlocks[1:] {
+		if block == nil || block.Uint64() != chain.Block {
+			t.Fatalf("call at block %v, want %d", block, chain.Block)
+		}
+	}
+
+	if report.Block != 1000 || report.RewardToken.Symbol != "MetaNode" || report.TotalPendingReward.Cmp(big.NewInt(15e17)) != 0 || len(report.Positions) != 3 {
+		t.Fatalf("report = %+v", report)
+	}
+	if got := report.Positions[0]; got.Pool.Symbol != "ETH" || got.Staked.Cmp(staketest.Ether(2)) != 0 || got.Locked().Cmp(staketest.Ether(2)) != 0 || got.Pool.UnstakeLockedBlocks != 20 {
+		t.Errorf("ETH position = %+v", got)
+	}
+	if got := report.Positions[1]; got.Pool.Symbol != "USDT" || got.Pool.Decimals != 6 || got.Pool.FormatAmount(got.Staked) != "1.5" || got.Locked().Sign() != 0 {
+		t.Errorf("USDT position = %+v", got)
+	}
+	if !report.Positions[2].Empty() || report.Positions[1].Empty() {
+		t.Errorf("Empty() = %v, %v", report.Positions[1].Empty(), report.Positions[2].Empty())
+	}
+
+	if _, err := client.Pool(context.Background(), 3); err == nil || !strings.Contains(err.Error(), "does not exist") {
+		t.Errorf("Pool(3): err = %v", err)
+	}
+}
+
+func TestClientCalls(t *testing.T) {
+	client, err := New(staketest.StakeAddress, staketest.NewChain())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	ethPool, err := client.Pool(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	usdt, err := client.Pool(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := Stake.MetaNodeStakeMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// ETH质押池通过 depositETH 质押，数量作为交易附带的ETH
+	call, err := client.Deposit(ethPool, staketest.Ether(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if call.Method != "depositETH" || call.To != staketest.StakeAddress || call.Value.Cmp(staketest.Ether(1)) != 0 || !bytes.Equal(call.Data, parsed.Methods["depositETH"].ID) {
+		t.Errorf("ETH deposit = %+v", call)
+	}
+
+	amount, err := usdt.ParseAmount("2.5")
+	if err != nil || amount.Int64() != 2_500_000 {
+		t.Fatalf("ParseAmount(2.5) = %v, %v", amount, err)
+	}
+	call, err = client.Deposit(usdt, amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := parsed.Pack("deposit", big.NewInt(1), amount)
+	if call.Method != "deposit" || call.Value != nil || !bytes.Equal(call.Data, want) {
+		t.Errorf("token deposit = %+v", call)
+	}
+
+	// 授权交易发往代币合约，被授权者是质押合约
+	call, err = client.Approve(usdt, amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ = ethtx.ERC20ABI.Pack("approve", staketest.StakeAddress, amount)
+	if call.To != staketest.USDTAddress || !bytes.Equal(call.Data, want) {
+		t.Errorf("approve = %+v", call)
+	}
+
+	// 从回执的事件中读取实际提取和领取的数量
+	withdrawEvent := parsed.Events["Withdraw"]
+	claimEvent := parsed.Events["Claim"]
+	withdrawData, _ := withdrawEvent.Inputs.NonIndexed().Pack(staketest.Ether(2))
+	claimData, _ := claimEvent.Inputs.NonIndexed().Pack(big.NewInt(42))
+	pid := common.BigToHash(big.NewInt(1))
+	receipt := &types.Receipt{Logs: []*types.Log{
+		{Address: staketest.StakeAddress, Topics: []common.Hash{claimEvent.ID, {}, pid}, Data: claimData},
+		{Address: staketest.USDTAddress, Topics: []common.Hash{withdrawEvent.ID, {}, pid, {}}, Data: withdrawData},
+		{Address: staketest.StakeAddress, Topics: []common.Hash{withdrawEvent.ID, {}, pid, common.BigToHash(big.NewInt(1000))}, Data: withdrawData},
+	}}
+	if got := client.Withdrawn(receipt); got == nil || got.Cmp(staketest.Ether(2)) != 0 {
+		t.Errorf("Withdrawn = %v", got)
+	}
+	if got := client.Claimed(receipt); got == nil || got.Int64() != 42 {
+		t.Errorf("Claimed = %v", got)
+	}
+	if got := client.Claimed(&types.Receipt{}); got != nil {
+		t.Errorf("Claimed without event = %v", got)
+	}
+}